@@ -1,4 +1,4 @@
-// Package assets contains the embedded assets like the json schema json doc and the swagger 2.0 schema doc
+// Package assets contains the embedded assets like the json schema json doc, the swagger 2.0 schema doc and the swagger-ui dist
 package assets
 
-//go:generate go-bindata -pkg=assets -prefix=../schemas -ignore=.*\.md ../schemas/...
+//go:generate go-bindata -pkg=assets -prefix=.. -ignore=.*\.md -ignore=LICENSE ../schemas/... ../swagger-ui/...
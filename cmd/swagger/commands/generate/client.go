@@ -0,0 +1,42 @@
+package generate
+
+import "github.com/go-swagger/go-swagger/generator"
+
+// Client the command to generate a swagger client
+type Client struct {
+	shared
+	Name       string   `long:"name" short:"A" description:"the name of the application, defaults to a mangled value of info.title"`
+	Operations []string `long:"operation" short:"O" description:"specify an operation to include, repeat for multiple"`
+	Models     []string `long:"model" short:"M" description:"specify a model to include, repeat for multiple"`
+	SkipModels bool     `long:"skip-models" description:"no models will be generated when this flag is specified"`
+	DumpData   bool     `long:"dump-data" description:"when present dumps the json for the template generator instead of generating files"`
+}
+
+// Execute runs this command
+func (c *Client) Execute(args []string) error {
+	typeMapping, imports, err := c.typeMapping()
+	if err != nil {
+		return err
+	}
+
+	opts := generator.GenOpts{
+		Spec:          string(c.Spec),
+		Target:        string(c.Target),
+		APIPackage:    c.APIPackage,
+		ModelPackage:  c.ModelPackage,
+		ServerPackage: c.ServerPackage,
+		ClientPackage: c.ClientPackage,
+		TemplateDir:   string(c.TemplateDir),
+		TypeMapping:   typeMapping,
+		Imports:       imports,
+		DumpData:      c.DumpData,
+	}
+
+	if !c.SkipModels && !c.DumpData {
+		if err := generator.GenerateModel(c.Models, true, true, opts); err != nil {
+			return err
+		}
+	}
+
+	return generator.GenerateClient(c.Name, c.Operations, opts)
+}
@@ -20,6 +20,11 @@ func (m *Model) Execute(args []string) error {
 	if m.DumpData && len(m.Name) > 1 {
 		return errors.New("only 1 model at a time is supported for dumping data")
 	}
+	typeMapping, imports, err := m.typeMapping()
+	if err != nil {
+		return err
+	}
+
 	return generator.GenerateModel(
 		m.Name,
 		!m.NoStruct,
@@ -31,6 +36,9 @@ func (m *Model) Execute(args []string) error {
 			ModelPackage:  m.ModelPackage,
 			ServerPackage: m.ServerPackage,
 			ClientPackage: m.ClientPackage,
+			TemplateDir:   string(m.TemplateDir),
+			TypeMapping:   typeMapping,
+			Imports:       imports,
 			DumpData:      m.DumpData,
 		})
 }
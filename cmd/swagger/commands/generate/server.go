@@ -1,6 +1,9 @@
 package generate
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/go-swagger/go-swagger/generator"
 	"github.com/jessevdk/go-flags"
 )
@@ -12,8 +15,37 @@ type shared struct {
 	ServerPackage string         `long:"server-package" short:"s" description:"the package to save the server specific code" default:"restapi"`
 	ClientPackage string         `long:"client-package" short:"c" description:"the package to save the client specific code" default:"client"`
 	Target        flags.Filename `long:"target" short:"t" default:"./" description:"the base directory for generating the files"`
-	// TemplateDir  flags.Filename `long:"template-dir"`
+	TemplateDir   flags.Filename `long:"template-dir" short:"T" description:"a directory with templates that override the default templates by file name, and shared partials in a partials directory"`
+	TypeMapping   []string       `long:"type-mapping" description:"map a format or a definition name to a go type, like money=github.com/acme/money.Amount, repeat for multiple"`
+	Imports       []string       `long:"import" description:"an alias for an import path to use in the type mapping, like money=github.com/acme/money, repeat for multiple"`
+}
 
+// typeMapping gets the type mapping and the imports from the name=value pairs of the flags
+func (s *shared) typeMapping() (map[string]string, map[string]string, error) {
+	typeMapping, err := keyValues("type-mapping", s.TypeMapping)
+	if err != nil {
+		return nil, nil, err
+	}
+	imports, err := keyValues("import", s.Imports)
+	if err != nil {
+		return nil, nil, err
+	}
+	return typeMapping, imports, nil
+}
+
+func keyValues(flag string, values []string) (map[string]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]string, len(values))
+	for _, v := range values {
+		parts := strings.SplitN(v, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid %s %q, expected name=value", flag, v)
+		}
+		result[parts[0]] = parts[1]
+	}
+	return result, nil
 }
 
 // Server the command to generate an entire server application
@@ -27,11 +59,16 @@ type Server struct {
 	SkipModels     bool     `long:"skip-models" description:"no models will be generated when this flag is specified"`
 	SkipOperations bool     `long:"skip-operations" description:"no operations will be generated when this flag is specified"`
 	SkipSupport    bool     `long:"skip-support" description:"no supporting files will be generated when this flag is specified"`
-	IncludeUI      bool     `long:"with-ui" description:"when generating a main package it uses a middleware that also serves a swagger-ui for the swagger json"`
+	IncludeUI      bool     `long:"with-ui" description:"when generating a main package it also serves the embedded swagger-ui for the swagger json at the docs path"`
 }
 
 // Execute runs this command
 func (s *Server) Execute(args []string) error {
+	typeMapping, imports, err := s.typeMapping()
+	if err != nil {
+		return err
+	}
+
 	opts := generator.GenOpts{
 		Spec:          string(s.Spec),
 		Target:        string(s.Target),
@@ -39,6 +76,9 @@ func (s *Server) Execute(args []string) error {
 		ModelPackage:  s.ModelPackage,
 		ServerPackage: s.ServerPackage,
 		ClientPackage: s.ClientPackage,
+		TemplateDir:   string(s.TemplateDir),
+		TypeMapping:   typeMapping,
+		Imports:       imports,
 		Principal:     s.Principal,
 	}
 
@@ -49,7 +89,7 @@ func (s *Server) Execute(args []string) error {
 	}
 
 	if !s.SkipOperations && (len(s.Operations) > 0 || len(s.Models) == 0) {
-		if err := generator.GenerateServerOperation(s.Operations, s.Tags, true, true, opts); err != nil {
+		if err := generator.GenerateServerOperation(s.Operations, s.Tags, true, true, true, opts); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,36 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharedTypeMapping(t *testing.T) {
+	s := &shared{
+		TypeMapping: []string{"money=money.Amount", "uuid=github.com/acme/ids.ID=v2"},
+		Imports:     []string{"money=github.com/acme/money"},
+	}
+	typeMapping, imports, err := s.typeMapping()
+	if assert.NoError(t, err) {
+		// only the first = separates the name from the value
+		assert.Equal(t, map[string]string{"money": "money.Amount", "uuid": "github.com/acme/ids.ID=v2"}, typeMapping)
+		assert.Equal(t, map[string]string{"money": "github.com/acme/money"}, imports)
+	}
+
+	typeMapping, imports, err = new(shared).typeMapping()
+	assert.NoError(t, err)
+	assert.Nil(t, typeMapping)
+	assert.Nil(t, imports)
+
+	for _, invalid := range []string{"money", "=money.Amount", "money="} {
+		_, _, err = (&shared{TypeMapping: []string{invalid}}).typeMapping()
+		if assert.Error(t, err, invalid) {
+			assert.Contains(t, err.Error(), "invalid type-mapping")
+		}
+	}
+	_, _, err = (&shared{Imports: []string{"github.com/acme/money"}}).typeMapping()
+	if assert.Error(t, err) {
+		assert.Equal(t, `invalid import "github.com/acme/money", expected name=value`, err.Error())
+	}
+}
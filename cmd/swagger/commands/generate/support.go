@@ -10,11 +10,16 @@ type Support struct {
 	Principal  string   `long:"principal" description:"the model to use for the security principal"`
 	Models     []string `long:"model" short:"M" description:"specify a model to include, repeat for multiple"`
 	DumpData   bool     `long:"dump-data" description:"when present dumps the json for the template generator instead of generating files"`
-	IncludeUI  bool     `long:"with-ui" description:"when generating a main package it uses a middleware that also serves a swagger-ui for the swagger json"`
+	IncludeUI  bool     `long:"with-ui" description:"when generating a main package it also serves the embedded swagger-ui for the swagger json at the docs path"`
 }
 
 // Execute generates the supporting files file
 func (s *Support) Execute(args []string) error {
+	typeMapping, imports, err := s.typeMapping()
+	if err != nil {
+		return err
+	}
+
 	return generator.GenerateSupport(
 		s.Name,
 		nil,
@@ -27,6 +32,9 @@ func (s *Support) Execute(args []string) error {
 			ModelPackage:  s.ModelPackage,
 			ServerPackage: s.ServerPackage,
 			ClientPackage: s.ClientPackage,
+			TemplateDir:   string(s.TemplateDir),
+			TypeMapping:   typeMapping,
+			Imports:       imports,
 			Principal:     s.Principal,
 			DumpData:      s.DumpData,
 		})
@@ -33,6 +33,9 @@ It aims to represent the contract of your API with a language agnostic descripti
 		case "support":
 			cmd.ShortDescription = "generate supporting files like the main function and the api builder"
 			cmd.LongDescription = cmd.ShortDescription
+		case "client":
+			cmd.ShortDescription = "generate all the files for a client library"
+			cmd.LongDescription = cmd.ShortDescription
 		case "operation":
 			cmd.ShortDescription = "generate one or more operations from the swagger spec"
 			cmd.LongDescription = cmd.ShortDescription
@@ -93,12 +93,13 @@ func MethodNotAllowed(requested string, allow []string) Error {
 
 // ServeError the error handler interface implemenation
 func ServeError(rw http.ResponseWriter, r *http.Request, err error) {
+	rw.Header().Set("Content-Type", "application/json")
 	switch e := err.(type) {
 	case *CompositeError:
 		er := flattenComposite(e)
 		ServeError(rw, r, er.Errors[0])
 	case *MethodNotAllowedError:
-		rw.Header().Add("Allow", strings.Join(err.(*MethodNotAllowedError).Allowed, ","))
+		rw.Header().Set("Allow", strings.Join(e.Allowed, ","))
 		rw.WriteHeader(int(e.Code()))
 		if r == nil || r.Method != "HEAD" {
 			rw.Write(errorAsJSON(e))
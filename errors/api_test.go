@@ -17,7 +17,7 @@ func TestServeError(t *testing.T) {
 	ServeError(recorder, nil, err)
 	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
 	assert.Equal(t, "POST,PUT", recorder.Header().Get("Allow"))
-	// assert.Equal(t, "application/json", recorder.Header().Get("content-type"))
+	assert.Equal(t, "application/json", recorder.Header().Get("content-type"))
 	assert.Equal(t, `{"code":405,"message":"method GET is not allowed, but [POST,PUT] are"}`, recorder.Body.String())
 
 	// renders status code from error when present
@@ -25,7 +25,7 @@ func TestServeError(t *testing.T) {
 	recorder = httptest.NewRecorder()
 	ServeError(recorder, nil, err)
 	assert.Equal(t, http.StatusNotFound, recorder.Code)
-	// assert.Equal(t, "application/json", recorder.Header().Get("content-type"))
+	assert.Equal(t, "application/json", recorder.Header().Get("content-type"))
 	assert.Equal(t, `{"code":404,"message":"Not found"}`, recorder.Body.String())
 
 	// defaults to internal server error
@@ -33,8 +33,16 @@ func TestServeError(t *testing.T) {
 	recorder = httptest.NewRecorder()
 	ServeError(recorder, nil, err)
 	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
-	// assert.Equal(t, "application/json", recorder.Header().Get("content-type"))
+	assert.Equal(t, "application/json", recorder.Header().Get("content-type"))
 	assert.Equal(t, `{"code":500,"message":"some error"}`, recorder.Body.String())
+
+	// replaces the content type a handler may have set before failing
+	err = NotFound("")
+	recorder = httptest.NewRecorder()
+	recorder.Header().Set("Content-Type", "text/html")
+	ServeError(recorder, nil, err)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("content-type"))
 }
 
 func TestAPIErrors(t *testing.T) {
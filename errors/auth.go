@@ -4,3 +4,9 @@ package errors
 func Unauthenticated(scheme string) Error {
 	return New(401, "unauthenticated for %s", scheme)
 }
+
+// Forbidden returns a forbidden error, this is used when the principal
+// was authenticated but lacks the scopes required for the operation
+func Forbidden(scheme string) Error {
+	return New(403, "insufficient scopes for %s", scheme)
+}
@@ -11,3 +11,9 @@ func TestUnauthenticated(t *testing.T) {
 	assert.EqualValues(t, 401, err.Code())
 	assert.Equal(t, "unauthenticated for basic", err.Error())
 }
+
+func TestForbidden(t *testing.T) {
+	err := Forbidden("oauth2")
+	assert.EqualValues(t, 403, err.Code())
+	assert.Equal(t, "insufficient scopes for oauth2", err.Error())
+}
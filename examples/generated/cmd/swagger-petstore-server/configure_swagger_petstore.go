@@ -1,8 +1,6 @@
 package main
 
 import (
-	"io"
-
 	"github.com/go-swagger/go-swagger/errors"
 	"github.com/go-swagger/go-swagger/httpkit"
 
@@ -21,15 +19,11 @@ func configureAPI(api *operations.SwaggerPetstoreAPI) {
 
 	api.JSONConsumer = httpkit.JSONConsumer()
 
-	api.XMLConsumer = httpkit.ConsumerFunc(func(r io.Reader, target interface{}) error {
-		return errors.NotImplemented("xml consumer has not yet been implemented")
-	})
+	api.XMLConsumer = httpkit.XMLConsumer()
 
 	api.JSONProducer = httpkit.JSONProducer()
 
-	api.XMLProducer = httpkit.ProducerFunc(func(w io.Writer, data interface{}) error {
-		return errors.NotImplemented("xml producer has not yet been implemented")
-	})
+	api.XMLProducer = httpkit.XMLProducer()
 
 	api.APIKeyAuth = func(token string) (*models.User, error) {
 		return nil, errors.NotImplemented("api key auth apiKey from header has not yet been implemented")
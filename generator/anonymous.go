@@ -0,0 +1,184 @@
+package generator
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/go-swagger/go-swagger/swag"
+)
+
+// hoistAnonymousSchemas moves the inline object schemas of the spec to definitions, so they get models of their own.
+// The schemas are replaced with a $ref to the new definition, which is named after where the schema was found:
+// PetOwner for the owner property of Pet, GetPetsOKBody for the 200 response of getPets and AddPetBody
+// for the body parameter of addPet.
+// Parameters and responses declared at the top of the spec are named after their key and the parameters of
+// a path after the path, the operations that refer to those with a $ref get the hoisted definition through them.
+func hoistAnonymousSchemas(specDoc *spec.Document) {
+	swspec := specDoc.Spec()
+	if swspec.Definitions == nil {
+		swspec.Definitions = make(spec.Definitions)
+	}
+	h := &schemaHoister{definitions: swspec.Definitions}
+
+	var names []string
+	for name := range swspec.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		schema := swspec.Definitions[name]
+		h.hoistNested(swag.ToGoName(name), &schema)
+		swspec.Definitions[name] = schema
+	}
+
+	var keys []string
+	for key := range swspec.Parameters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		h.hoistParams(swag.ToGoName(key), []spec.Parameter{swspec.Parameters[key]})
+	}
+
+	keys = nil
+	for key := range swspec.Responses {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		h.hoist(swag.ToGoName(key)+"Body", swspec.Responses[key].Schema)
+	}
+
+	if swspec.Paths != nil {
+		keys = nil
+		for path := range swspec.Paths.Paths {
+			keys = append(keys, path)
+		}
+		sort.Strings(keys)
+		for _, path := range keys {
+			h.hoistParams(swag.ToGoName(path), swspec.Paths.Paths[path].Parameters)
+		}
+	}
+
+	// operations without an id are named after their method and path, like GetPetsID for GET /pets/{id}
+	var operations namedOperations
+	for method, byPath := range specDoc.Operations() {
+		for path, operation := range byPath {
+			opName := swag.ToGoName(operation.ID)
+			if opName == "" {
+				opName = swag.ToGoName(strings.ToLower(method) + " " + path)
+			}
+			operations = append(operations, namedOperation{Name: opName, Operation: operation})
+		}
+	}
+	sort.Sort(operations)
+
+	for _, named := range operations {
+		opName, operation := named.Name, named.Operation
+		h.hoistParams(opName, operation.Parameters)
+
+		if operation.Responses == nil {
+			continue
+		}
+		var codes []int
+		for code := range operation.Responses.StatusCodeResponses {
+			codes = append(codes, code)
+		}
+		sort.Ints(codes)
+		for _, code := range codes {
+			name := swag.ToGoName(http.StatusText(code))
+			if name == "" {
+				name = "Status" + strconv.Itoa(code)
+			}
+			h.hoist(opName+name+"Body", operation.Responses.StatusCodeResponses[code].Schema)
+		}
+		if operation.Responses.Default != nil {
+			h.hoist(opName+"DefaultBody", operation.Responses.Default.Schema)
+		}
+	}
+}
+
+type namedOperation struct {
+	Name      string
+	Operation *spec.Operation
+}
+
+type namedOperations []namedOperation
+
+func (s namedOperations) Len() int           { return len(s) }
+func (s namedOperations) Less(i, j int) bool { return s[i].Name < s[j].Name }
+func (s namedOperations) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type schemaHoister struct {
+	definitions spec.Definitions
+}
+
+// isAnonymousObject returns true for the inline schemas that get a struct when they're a definition
+func isAnonymousObject(schema *spec.Schema) bool {
+	return schema.Ref.GetURL() == nil && (len(schema.Properties) > 0 || len(schema.AllOf) > 0)
+}
+
+// hoist moves the schema to a definition with the name when it's an anonymous object
+func (h *schemaHoister) hoist(name string, schema *spec.Schema) {
+	if schema == nil || schema.Ref.GetURL() != nil {
+		return
+	}
+	if !isAnonymousObject(schema) {
+		h.hoistNested(name, schema)
+		return
+	}
+
+	name = h.uniqueName(name)
+	h.hoistNested(name, schema)
+	h.definitions[name] = *schema
+	*schema = *spec.RefProperty("#/definitions/" + name)
+}
+
+// hoistNested moves the anonymous objects in the properties and items of the schema to definitions
+func (h *schemaHoister) hoistNested(name string, schema *spec.Schema) {
+	var props []string
+	for pn := range schema.Properties {
+		props = append(props, pn)
+	}
+	sort.Strings(props)
+	for _, pn := range props {
+		prop := schema.Properties[pn]
+		h.hoist(name+swag.ToGoName(pn), &prop)
+		schema.Properties[pn] = prop
+	}
+
+	if schema.Items != nil && schema.Items.Schema != nil {
+		h.hoist(name+"Items", schema.Items.Schema)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		h.hoist(name+"AdditionalProperties", schema.AdditionalProperties.Schema)
+	}
+
+	// the properties of inline allOf members end up in the model for the schema
+	for i := range schema.AllOf {
+		h.hoistNested(name, &schema.AllOf[i])
+	}
+}
+
+// hoistParams moves the schema of a body parameter to a definition named after the owner of the parameters
+func (h *schemaHoister) hoistParams(name string, params []spec.Parameter) {
+	for _, param := range params {
+		if param.In == "body" {
+			h.hoist(name+"Body", param.Schema)
+		}
+	}
+}
+
+// uniqueName appends a number to the name when there's a definition with the name already
+func (h *schemaHoister) uniqueName(name string) string {
+	result := name
+	for i := 2; ; i++ {
+		if _, exists := h.definitions[result]; !exists {
+			return result
+		}
+		result = name + strconv.Itoa(i)
+	}
+}
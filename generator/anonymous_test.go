@@ -0,0 +1,132 @@
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+const anonymousSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "pets", "version": "1"},
+  "paths": {
+    "/pets": {
+      "get": {
+        "operationId": "getPets",
+        "responses": {
+          "200": {"description": "pets", "schema": {"type": "object", "properties": {"pets": {"type": "array", "items": {"$ref": "#/definitions/Pet"}}}}}
+        }
+      },
+      "post": {
+        "operationId": "addPet",
+        "parameters": [{"name": "pet", "in": "body", "schema": {"type": "object", "properties": {"name": {"type": "string"}}}}],
+        "responses": {"201": {"description": "created"}}
+      }
+    },
+    "/pets/{id}": {
+      "parameters": [{"name": "id", "in": "path", "required": true, "type": "integer"}],
+      "patch": {
+        "operationId": "patchPet",
+        "parameters": [{"$ref": "#/parameters/petPatch"}],
+        "responses": {"200": {"$ref": "#/responses/petUpdated"}}
+      },
+      "put": {
+        "parameters": [
+          {"name": "id", "in": "path", "required": true, "type": "integer"},
+          {"name": "pet", "in": "body", "schema": {"type": "object", "properties": {"name": {"type": "string"}}}}
+        ],
+        "responses": {"200": {"description": "updated", "schema": {"type": "object", "properties": {"id": {"type": "integer"}}}}}
+      }
+    },
+    "/owners/{id}": {
+      "parameters": [
+        {"name": "id", "in": "path", "required": true, "type": "integer"},
+        {"name": "owner", "in": "body", "schema": {"type": "object", "properties": {"name": {"type": "string"}}}}
+      ],
+      "put": {"operationId": "updateOwner", "responses": {"204": {"description": "updated"}}}
+    }
+  },
+  "parameters": {
+    "petPatch": {"name": "patch", "in": "body", "schema": {"type": "object", "properties": {"name": {"type": "string"}}}}
+  },
+  "responses": {
+    "petUpdated": {"description": "updated", "schema": {"type": "object", "properties": {"id": {"type": "integer"}}}}
+  },
+  "definitions": {
+    "Pet": {
+      "type": "object",
+      "properties": {
+        "owner": {"type": "object", "properties": {"name": {"type": "string"}}},
+        "tags": {"type": "array", "items": {"type": "object", "properties": {"label": {"type": "string"}}}},
+        "name": {"type": "string"}
+      }
+    },
+    "PetOwner": {"type": "string"}
+  }
+}`
+
+func TestHoistAnonymousSchemas(t *testing.T) {
+	specDoc, err := spec.New(json.RawMessage(anonymousSpec), "")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	hoistAnonymousSchemas(specDoc)
+	definitions := specDoc.Spec().Definitions
+
+	// the nested objects are named after the definition and the property,
+	// PetOwner is taken already so the owner gets the next free name
+	pet := definitions["Pet"]
+	owner := pet.Properties["owner"]
+	assert.Equal(t, "PetOwner2", refName(&owner))
+	assert.Equal(t, "PetTagsItems", refName(pet.Properties["tags"].Items.Schema))
+	name := pet.Properties["name"]
+	assert.Empty(t, refName(&name))
+	assert.Contains(t, definitions["PetOwner2"].Properties, "name")
+	assert.True(t, definitions["PetOwner"].Type.Contains("string"))
+
+	// bodies and responses are named after the operation
+	getPets, _ := specDoc.OperationForName("getPets")
+	assert.Equal(t, "GetPetsOKBody", refName(getPets.Responses.StatusCodeResponses[200].Schema))
+	assert.Contains(t, definitions["GetPetsOKBody"].Properties, "pets")
+	addPet, _ := specDoc.OperationForName("addPet")
+	assert.Equal(t, "AddPetBody", refName(addPet.Parameters[0].Schema))
+
+	// an operation without an id is named after its method and path
+	updatePet, _ := specDoc.OperationFor("PUT", "/pets/{id}")
+	assert.Equal(t, "PutPetsIDBody", refName(updatePet.Parameters[1].Schema))
+	assert.Equal(t, "PutPetsIDOKBody", refName(updatePet.Responses.StatusCodeResponses[200].Schema))
+
+	// parameters and responses at the top are named after their key, the operations refer to them
+	swspec := specDoc.Spec()
+	assert.Equal(t, "PetPatchBody", refName(swspec.Parameters["petPatch"].Schema))
+	assert.Contains(t, definitions["PetPatchBody"].Properties, "name")
+	assert.Equal(t, "PetUpdatedBody", refName(swspec.Responses["petUpdated"].Schema))
+	assert.Contains(t, definitions["PetUpdatedBody"].Properties, "id")
+	patchPet, _ := specDoc.OperationForName("patchPet")
+	paramRef, responseRef := patchPet.Parameters[0].Ref, patchPet.Responses.StatusCodeResponses[200].Ref
+	assert.Equal(t, "#/parameters/petPatch", paramRef.String())
+	assert.Equal(t, "#/responses/petUpdated", responseRef.String())
+	if patch, ok := specDoc.ParamsFor("PATCH", "/pets/{id}")["Patch"]; assert.True(t, ok) {
+		assert.Equal(t, "PetPatchBody", refName(patch.Schema))
+	}
+
+	// the parameters of a path are named after the path
+	owners := swspec.Paths.Paths["/owners/{id}"]
+	assert.Equal(t, "OwnersIDBody", refName(owners.Parameters[1].Schema))
+	assert.Contains(t, definitions["OwnersIDBody"].Properties, "name")
+	params := specDoc.ParamsFor("PUT", "/owners/{id}")
+	if assert.Contains(t, params, "Owner") {
+		assert.Equal(t, "OwnersIDBody", refName(params["Owner"].Schema))
+	}
+}
+
+func TestHoisterUniqueName(t *testing.T) {
+	h := &schemaHoister{definitions: spec.Definitions{
+		"PetOwner":  spec.Schema{},
+		"PetOwner2": spec.Schema{},
+	}}
+	assert.Equal(t, "PetTags", h.uniqueName("PetTags"))
+	assert.Equal(t, "PetOwner3", h.uniqueName("PetOwner"))
+}
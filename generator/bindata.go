@@ -1,5 +1,8 @@
 // Code generated by go-bindata.
 // sources:
+// templates/client/client.gotmpl
+// templates/client/facade.gotmpl
+// templates/client/parameter.gotmpl
 // templates/model.gotmpl
 // templates/modelvalidator.gotmpl
 // templates/server/builder.gotmpl
@@ -7,6 +10,7 @@
 // templates/server/main.gotmpl
 // templates/server/operation.gotmpl
 // templates/server/parameter.gotmpl
+// templates/server/responses.gotmpl
 // DO NOT EDIT!
 
 package generator
@@ -16,11 +20,11 @@ import (
 	"compress/gzip"
 	"fmt"
 	"io"
-	"strings"
-	"os"
-	"time"
 	"io/ioutil"
+	"os"
 	"path/filepath"
+	"strings"
+	"time"
 )
 
 func bindataRead(data []byte, name string) ([]byte, error) {
@@ -49,9 +53,9 @@ type asset struct {
 }
 
 type bindataFileInfo struct {
-	name string
-	size int64
-	mode os.FileMode
+	name    string
+	size    int64
+	mode    os.FileMode
 	modTime time.Time
 }
 
@@ -74,7 +78,67 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesModelGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x92\xcb\x4e\xf3\x30\x10\x85\xf7\x79\x8a\x51\xd4\x5f\xfa\x91\x20\xdd\x57\x62\x05\x2c\x90\x00\x21\xd1\x05\xcb\x9a\x64\x92\x98\xfa\x12\xec\x49\x4b\x64\xf9\xdd\xb1\x9d\xa4\xb4\x20\xba\xf3\xe5\x9b\x33\x3e\x73\xec\x5c\x85\x35\x57\x08\xb9\xd4\x15\x8a\xce\xe8\x0e\x0d\x0d\xb9\xf7\x99\x73\xbc\x86\xe2\x56\x97\x2f\x64\xb8\x6a\xbc\x77\xee\x74\x87\xaa\x4a\x58\xf1\x3c\x55\x3d\x31\x89\xde\x43\xe4\x18\xb1\xf5\xd0\xc5\xdd\xe6\xdd\x6a\xb5\xca\x23\xc6\x0c\x93\x23\x93\x8f\xe2\xaf\x8f\x0f\x53\xcd\xa7\x14\x89\x39\x9c\x4c\xf2\xf9\x26\x9b\x1b\x65\x1d\x2b\xb7\xac\x41\x48\x52\x69\x19\x4f\x97\x4b\x58\xb7\xdc\x42\xcd\x05\xc2\x9e\x59\x68\x50\xa1\x61\x84\x15\xbc\x0d\x40\x2d\x82\xdd\xb3\xa6\x41\x03\xa4\xb5\x28\x22\x7f\x57\x71\x0a\x1e\xc2\xe5\x5c\x27\x79\xd3\x12\x04\xf7\x3b\x84\xba\xa7\x24\xd5\xa2\x82\x41\xf7\x60\xf0\xca\xf4\xea\x44\x69\x6e\x01\xa5\x96\x92\xa9\x2a\x9b\x87\x85\x35\xeb\x05\xdd\xcb\x4e\x1b\xb2\xde\xf3\xb4\x80\xff\x19\x84\x47\x1b\xa6\xc2\xe3\x7f\x31\xce\x75\x61\xa0\x54\x43\xfe\xef\x23\x87\x22\x58\x8a\xf0\x68\xf9\xe2\xdb\xfc\xd8\xe0\x9c\xf2\x62\x8b\xc3\x25\x2c\x76\x4c\xf4\x08\xab\xeb\x23\xd8\xb9\x78\x97\x92\x81\xe3\x66\x23\x7b\xbe\xe3\x9f\xf9\xcf\x20\x85\x98\x63\x24\x37\x82\x59\x3b\xa5\x69\xc9\xf4\x25\x81\xcb\x0e\xae\xa7\x2f\xc2\xd1\xa6\x4a\x42\xd9\x89\x38\xc0\x1f\xdf\x2e\xf9\x9f\x5f\xe0\xb3\xaf\x00\x00\x00\xff\xff\xbc\x33\x14\x7a\x9e\x02\x00\x00")
+var _templatesClientClientGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8d\x55\xdb\x8e\xdb\x36\x10\x7d\xd7\x57\x4c\x8d\xd6\x90\x0c\x45\x7e\x77\xb1\x0f\xc5\x26\x41\xb7\xc0\x5e\xe0\x6d\x5f\x5a\x14\x01\x2d\x8f\x2d\x26\x12\xa9\x90\xd4\xba\x86\xa0\x7f\xcf\xf0\x26\xf9\xb2\x9b\xe6\x45\x22\x39\x3c\x33\x67\xce\x8c\x46\x2d\x2b\xbf\xb0\x3d\x42\xdf\x17\x0f\xac\xc1\x61\x48\x92\xe5\x12\xfe\xac\xb8\x86\x1d\xaf\x11\x0e\x4c\xc3\x1e\x05\x2a\x66\x70\x0b\x9b\x23\x98\x0a\x41\x1f\xd8\x7e\x8f\x0a\x8c\x94\x75\x61\xef\x7f\xd8\x72\xc3\xc5\x9e\x8c\x11\xd7\xf0\x7d\x65\xa0\x55\xf2\x05\x61\xd7\x19\xe7\xaa\x42\x01\x47\xd9\x81\xc2\x77\xaa\x13\x67\x9e\x62\x08\x28\x65\xd3\x30\xb1\x4d\x12\xde\xb4\x52\x19\x48\x13\x80\xd9\xe6\x68\x50\xcf\xec\x0a\x45\x29\xb7\x14\x69\xf9\x59\x4b\xe1\x4e\xb8\x9c\x25\xf6\xbd\xe7\xa6\xea\x36\x05\xe1\x97\x7b\xf9\x2e\xf8\x3d\x5d\x56\xc6\xb4\x5f\xb8\xb1\x20\xbb\x2c\x6b\x8e\xc2\xfc\x38\x6e\xe9\x01\x2e\x58\xdf\x2b\x26\x48\xb4\xe2\x3d\xee\x58\x57\x9b\x3b\xc7\x55\x0f\x43\xdf\xb7\x8a\x0b\xb3\x83\xd9\x2f\x5f\x67\x50\x90\x9a\xf6\x32\x8a\x2d\xad\x32\xa7\xec\x03\x1e\xa0\x54\x48\xa9\x6a\x60\x20\x68\x47\xca\xff\xde\x51\xce\xb7\x35\xd3\xda\xd7\x00\x7e\x7b\xba\x03\x1f\xaf\x48\x76\x9d\x28\x2d\x2c\x35\x14\x54\x3b\x51\x16\x53\x02\xc5\xba\x13\x86\x37\x98\xc1\xe2\xd6\x67\xd4\x53\x4c\x85\xa6\x53\x02\xe6\xfe\xa8\x1f\x91\x2b\x18\x97\x43\xe2\x4b\x1d\x50\x3b\xa9\xde\x62\x92\x98\x63\x8b\xf1\x9e\x36\xaa\x2b\x7d\x90\xef\xf2\xb1\xde\x47\x99\x1e\x5b\x5b\x5c\x2e\x05\x49\x64\x63\x52\xa0\xd3\x18\x7d\xcf\x77\x50\x3c\x77\x54\x79\x75\xb4\x22\x9e\xae\xb1\xd6\xe8\xce\xde\xa3\x2e\x15\x6f\xad\x1b\x77\x6e\x45\xf5\xc8\x0f\x4a\x49\x75\x2f\xb7\x58\x7b\xf7\xae\xd1\x6c\x77\x59\x19\x15\x12\x47\xb1\xd5\x70\xa0\x42\x03\x13\x80\xf6\x76\xee\xec\x5e\x26\xea\x6b\x77\x06\xd4\xbb\x64\x5f\x10\xca\x79\x0c\x08\xcb\xf6\x34\x02\xbc\xb0\xba\xc3\x58\x55\x57\x9d\xb4\xef\x7f\x2e\xd6\x58\x22\x7f\x41\x15\x92\x0a\xe5\xc8\x2e\x92\x4d\x3d\xe5\x27\xa6\x58\x43\x6a\xb4\xee\x0d\x8b\xf3\x4b\xde\x1a\x42\x64\x90\x46\x81\xca\x12\xb5\x0e\x34\xfc\xd9\xda\x65\xa0\x6f\x65\xd3\xd6\xf8\xdf\xe3\xe6\x33\x96\x66\x18\x16\xa3\x3c\x17\xa0\x3c\x76\xa3\x4b\x38\x73\x65\x7c\xcd\xf9\x0b\x53\x56\x38\x6a\x6d\xb8\xf2\x31\xb5\xf4\xb5\xfa\x16\x87\xe3\xfe\x52\xb9\x09\x29\x63\x43\xe4\xf0\x09\x56\x37\x70\xad\x5f\x31\x76\x57\xf1\xdc\x62\x39\xb5\xd0\x47\xe9\x6e\xa4\xb3\x71\x62\xcd\x32\x72\x4c\x51\xff\xdf\x51\xb7\x69\xb8\x49\xe7\xa7\xdd\x8a\x5f\x3b\xd4\xc6\xea\x00\x70\x8f\xa6\x92\xdb\x95\x5d\x5a\xef\x7e\x4b\xfe\x73\x67\x7d\x62\xa6\x72\x36\x6f\xb5\xdb\xd1\x36\xb2\x5b\xc1\x94\x9a\xb3\x9c\x97\xdb\xbf\x57\xe0\xcb\x5e\x18\x79\xcf\xda\x34\x8b\x37\xdf\x10\x75\x5a\xaf\x60\x3e\xa9\x7b\x86\xa2\xb5\x2b\xee\x49\x29\xef\xf4\x93\xac\x8f\x8d\x54\x6d\xc5\xcb\x61\x38\x4b\xda\x7e\x12\x1a\xd7\xc8\xb6\xa8\x3e\x52\x07\xa7\xb6\x8d\x53\xe5\xf6\xc0\x65\xe1\x2d\x39\x4d\x63\xa1\xbb\x86\xce\xc2\x10\x2c\x6e\xc3\x41\x16\x3e\x99\xfe\x24\xc9\xb1\x17\x85\x61\x9c\xc6\xb9\x6f\x07\x6e\x90\x1a\xfc\x9f\x7f\xed\xc0\x2e\xd6\xec\x70\x4f\xd4\xe8\x87\xe3\x70\x84\x0a\x75\x8b\x81\x62\x80\xc0\x25\x87\xb9\xc3\x67\xbf\xba\x8b\x3f\xdd\x80\xe0\x75\x08\x3a\x4e\x39\xb2\xb8\x83\xc1\x3d\xed\x20\xfb\x94\xbb\xb0\xd6\xb1\x9f\x40\x9e\x44\xc4\xb9\x0f\x38\x9f\x5a\x26\x2a\xf6\x97\xa0\xa1\xa3\x2b\x56\xd7\x96\x7c\xea\xfe\x3a\x05\x4d\x5e\xaf\x46\x6a\x9d\x64\xf9\x28\xc5\x1f\xcf\x8f\x0f\x51\x8e\x34\xcb\x82\xef\x90\xd2\x05\xd3\x2b\xae\x91\x2d\xc4\xcf\xec\x06\x58\xdb\x52\x29\x53\xbf\xcf\x3d\xc9\xec\x24\xb1\xe0\x81\xdc\xc6\xa1\xf8\x83\x79\xa8\x8b\x62\x66\xc9\xdb\x44\x5f\x95\x74\xa4\xe8\x02\x5e\x93\x89\x0d\x98\x79\x62\x70\x3d\x4f\xe6\xde\x45\x24\x3e\xc1\x2c\x97\xd7\x98\x04\xff\xaf\x0f\xbe\x78\xf0\x37\x2a\x79\x3e\xd3\x12\x4f\xf9\xbb\xe8\xb7\xc7\xe6\x3c\x38\x8a\x25\x08\x5b\x62\x45\x7f\xb3\x98\xe7\x37\x3f\x0c\xdd\xf6\x31\x09\x00\x00")
+
+func templatesClientClientGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientClientGotmpl,
+		"templates/client/client.gotmpl",
+	)
+}
+
+func templatesClientClientGotmpl() (*asset, error) {
+	bytes, err := templatesClientClientGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/client.gotmpl", size: 2353, mode: os.FileMode(420), modTime: time.Unix(1792202770, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _templatesClientFacadeGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8d\x52\x5d\x6f\x9b\x40\x10\x7c\xe7\x57\x6c\x51\x2a\x81\xe5\xe0\xf7\x4a\x7e\x88\xd2\xaa\x69\xa5\x3a\x51\xec\x3f\x70\xc1\x0b\x5c\x0d\x77\xf4\x3e\x6c\x59\x88\xff\xde\xdd\x03\xdb\xd0\x4a\x69\xdf\x4e\x7b\xb3\xb3\xb3\x33\xdb\x8a\xfc\x20\x4a\x84\xae\xcb\x5e\x86\x67\xdf\x47\xd1\x6a\x05\xbb\x4a\x5a\x28\x64\x8d\x70\x12\x16\x4a\x54\x68\x84\xc3\x3d\xbc\x9d\xc1\x55\x08\xf6\x24\xca\x12\x0d\x38\xad\xeb\x8c\xf1\x5f\xf6\xd2\x49\x55\xd2\xe7\xa5\xaf\x91\x65\xe5\xa0\x35\xfa\x88\x50\x78\x17\xa8\x2a\x54\x70\xd6\x1e\x0c\xde\x1b\xaf\x66\x4c\x97\x11\x90\xeb\xa6\x11\x6a\x1f\x45\xb2\x69\xb5\x71\x90\x44\x00\x31\xaa\x5c\xef\x89\x7f\xf5\xd3\x6a\x15\x47\x5c\x2a\xa5\xab\xfc\x5b\x46\xf0\x55\xa9\xef\x47\x9a\xe9\xd3\xb6\x98\xc7\x84\xac\x9c\x6b\xf3\x5a\xa2\x72\xff\xd1\xc4\xe0\x83\x74\xab\xa1\x21\x4c\xea\x3a\x23\x14\x79\x94\x7d\xc6\x42\xf8\xda\x7d\x0b\xba\x6c\xdf\x77\x5d\x6b\xa4\x72\x05\xc4\x1f\x7f\xc5\x90\x91\x73\x0c\x46\xb5\x1f\x5f\x43\xdb\xdd\x01\xcf\x4b\xb8\x3b\x8a\xda\x23\x7c\x5a\x43\x36\xe9\xe7\xbf\xbe\x27\x28\x4c\x99\x06\xec\x8c\x2e\x8d\xa2\xa3\x30\x17\xb3\xbe\x6f\x9f\x37\xb0\x06\xf6\x22\x7b\x15\xa7\x1f\x68\x2d\x25\x97\x50\x88\xdb\x1b\xa0\xef\xd3\x90\xe4\x06\x4f\x4f\xbb\xdd\xcb\xe3\xe0\x40\x6e\x90\x4c\xb6\x20\x40\xe1\x89\x63\x7f\xf2\xe4\xf6\x43\xdb\x6e\x44\x43\x23\x81\xa1\x30\x2c\xbf\xe4\x6e\x8e\xc8\xd1\x22\x36\x64\x41\xd9\xe6\x5a\x15\xb2\xf4\x86\x6e\xe1\x44\x66\xce\x32\x64\xc7\x65\x21\x73\xe1\xa4\x1e\xd2\x1d\x7d\x9f\xdf\x50\x61\x74\x13\x15\x5e\xe5\x73\x6d\x49\x0a\xc9\x82\x14\x5d\xc5\x2c\x01\x8d\xd1\x26\x85\x8e\x9c\x18\x67\x6c\x69\x44\xa8\xb3\x95\x3c\x2f\x23\x8e\x64\xe2\xcb\x12\xe2\x38\x25\xbc\x2c\x02\xea\xc3\x1a\x94\xac\x03\x03\xd0\xd9\x39\x6f\x14\x17\x02\x05\xd5\xd8\xe3\xb1\xca\x3c\xb7\x53\x99\xd2\xf2\xcc\x34\x5d\x72\x5f\xd4\x5f\x3c\xfd\xa7\x93\x03\xcf\x75\xcf\xe4\xe6\xe2\x62\x32\xe6\xd5\x2b\x27\x1b\x4c\x61\xb6\x79\xd0\x4b\x08\x5e\x92\xd8\x93\xe9\x5f\x3a\x7c\x65\xbb\x2b\xdf\xfa\x96\xd0\xf4\x5c\x9f\x5b\xf6\x9b\x92\xf8\x6a\xb4\x6f\x6d\xb8\x27\x6e\x24\xb2\xc7\x5a\x58\x3b\x8e\x5a\xb3\xf6\xe1\x9d\xcd\x74\xa6\xb3\x73\x1e\x5d\x22\x82\xd1\x83\x99\x5e\xc9\x3e\x8c\x61\x17\xda\xfc\x6d\x47\xe4\xce\x2d\xce\x7b\xac\x33\x3e\x77\x61\xd5\x77\x35\xff\xa1\x77\x71\x93\x3b\xdc\xcd\x4c\xe6\xee\x3d\x97\x49\xf9\x6f\xd0\xae\xd2\x6d\xf1\x04\x00\x00")
+
+func templatesClientFacadeGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientFacadeGotmpl,
+		"templates/client/facade.gotmpl",
+	)
+}
+
+func templatesClientFacadeGotmpl() (*asset, error) {
+	bytes, err := templatesClientFacadeGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/facade.gotmpl", size: 1265, mode: os.FileMode(420), modTime: time.Unix(1792197891, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _templatesClientParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x75\x53\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x38\xa3\xc3\x92\xa2\x75\xee\x03\x72\x18\xb6\x02\xcb\x61\x45\x50\x14\xbb\x14\x3d\x28\x0e\x6d\x0b\x91\x25\x55\xa2\xd2\x05\x86\xff\x7d\x94\xec\xb4\xf6\x96\x9e\x2c\x89\x8f\x8f\x8f\x8f\xb4\x15\xe5\x41\xd4\x08\x5d\x57\x6c\x87\x63\xdf\x67\xd9\x6a\x05\x8f\x8d\xf4\x50\x49\x85\xf0\x2a\x3c\xd4\xa8\xd1\x09\xc2\x3d\xec\x4e\x40\x0d\x82\x7f\x15\x75\x8d\x0e\xc8\x18\x55\x44\xfc\xdd\x5e\x92\xd4\x35\x07\xcf\x79\xad\xac\x1b\x02\xeb\xcc\x11\xa1\x0a\x94\xa8\x1a\xd4\x70\x32\x01\x1c\xde\xba\xa0\x67\x4c\xe7\x12\x50\x9a\xb6\x15\x7a\x9f\x65\xb2\xb5\xc6\x11\x2c\x32\x80\xbc\x96\xd4\x84\x5d\xc1\xb1\x55\x6d\x6e\xc7\x9c\xe9\xb1\x21\xb2\x07\x49\x79\xc6\xe8\xae\x73\x42\x73\x53\xc5\x0f\xac\x44\x50\xb4\x49\x44\xbe\xef\xbb\xce\x3a\xa9\xa9\x82\xfc\xf3\x4b\x0e\x05\xb7\x1a\xc1\xa8\xf7\xe3\x69\x48\xbb\x3a\xe0\xe9\x06\xae\x8e\x42\x05\x84\xaf\x6b\x28\x26\xf9\x31\xd6\xf7\x0c\x85\x29\xd3\x80\x9d\xd1\x2d\x93\x8b\x6c\xeb\x77\x25\xbc\xbf\x17\x2d\x87\xb7\xc2\x89\xd6\x73\x83\x9a\x84\xd4\x1e\x84\x52\xc9\x02\x1b\xdf\x91\xd0\x79\xf6\x13\x3c\x13\xc4\x6f\x8c\x7c\xdb\x6e\x80\xaf\xd6\x70\xad\xc8\x57\x19\x97\xde\x99\xf7\x67\x60\x97\x26\xe4\x60\x6c\xf4\x4f\x1a\xb6\xf5\x64\x65\xc9\xe4\x69\x54\x1e\x41\x38\xb6\xde\x49\x22\x76\x9f\x89\x05\x44\xb3\x8a\x07\x7c\x09\xe8\x29\x63\x34\x5e\x16\xea\xc9\x85\x92\xa0\x9b\x5a\x3a\x84\xa2\x15\xb2\x8a\x06\xfb\xd2\x49\x1b\xab\xf6\xfd\xd0\xef\xec\x69\x6a\x6e\xb1\x75\x51\x22\x9d\x46\xbd\x03\xc3\xc6\xdf\x07\xa5\xc4\x4e\xf1\xd3\xf5\x08\x67\xec\x23\x8b\x9a\xf9\x39\x6c\x25\x99\x5f\xc2\x02\x05\xc7\xee\xfd\xe3\x1c\x3b\x14\x5b\x6b\x39\xce\x33\x7a\xdf\xd4\x37\x08\x68\xfe\xf2\x6e\x3a\xd3\x0e\x8b\x67\xb1\xbc\x49\xa4\x7c\x31\x49\xb0\x50\xb3\x59\x34\x82\xa2\x77\xfa\x0b\xf1\x50\xd2\x11\x14\x56\x04\x26\x50\x56\x05\x5d\xc2\x82\x95\x3e\x60\x89\xf2\x88\x6e\xec\xea\xfa\x92\x93\xcb\x41\xf8\x62\x19\xe5\x3d\xb1\xad\xfc\xa7\x3c\xb3\x60\x74\x95\x28\xb1\xeb\x93\xc5\x0e\x3d\xef\x6a\x5c\xb8\x56\x1c\x70\x71\x19\xb9\xfc\x78\x16\x53\x27\xf9\xce\x3a\x7e\xc7\xad\xbc\xfb\x63\x99\xd9\xa7\x71\xc0\xa7\x35\x68\xa9\x52\xb9\x73\xc1\xa7\x9c\x91\x83\xd8\xfc\x19\xd6\xa9\x81\xff\x12\x19\x3f\x0e\x43\x79\x06\x5e\xce\xfc\x20\xf1\x6d\xa8\xe7\x55\x70\x18\x07\x38\x96\xe7\xc1\xfe\x05\xff\x95\x05\x2b\x84\x04\x00\x00")
+
+func templatesClientParameterGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientParameterGotmpl,
+		"templates/client/parameter.gotmpl",
+	)
+}
+
+func templatesClientParameterGotmpl() (*asset, error) {
+	bytes, err := templatesClientParameterGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/parameter.gotmpl", size: 1156, mode: os.FileMode(420), modTime: time.Unix(1792202106, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _templatesModelGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x58\x59\x8f\xdb\x36\x10\x7e\xf7\xaf\x98\x0a\xdb\xd4\x2a\x14\xb9\xcf\x5b\xa4\x40\x9b\xa3\x49\xd1\xa4\x41\x92\x06\x05\x16\x8b\x2e\x2d\xd1\x36\x13\x49\x54\x49\x6a\x1d\xc3\xd0\x7f\xef\xf0\xd0\x41\x59\xb2\xb5\x01\xda\x07\xc3\x3a\x86\x73\x7f\x73\xe8\x78\x4c\xe9\x86\x15\x14\x82\x9c\xa7\x34\x2b\x05\x2f\xa9\x50\x87\xa0\xae\x17\xc7\x23\xdb\x40\xfc\x8c\x27\xef\x95\x60\xc5\xb6\xae\x8f\x47\xff\x8e\x16\xa9\x21\x8b\xdf\xba\x53\x6f\x48\x4e\xeb\x1a\x34\x1d\x51\xe4\xc3\xa1\xd4\x77\x77\x9f\x24\x2f\xae\x03\x4d\x46\x04\xc9\x2d\x4d\x60\x99\xff\xf5\xfa\x77\x77\xe6\x4b\x9e\x19\x9a\xf6\x49\xe0\xf8\xdf\x2d\x3a\x41\x8d\xaa\x25\xcf\x0e\x39\x17\xe5\x8e\x25\xa7\x0a\xbf\x92\x4f\x79\xa1\x08\x12\x8a\xba\xde\x70\x01\x7f\x47\xc0\x14\xcd\xe1\xfa\x09\x08\x52\x6c\x29\xe8\x33\x32\x1e\xd1\x7b\x01\x70\x4f\xb2\x8a\x46\x40\x85\xd0\xf4\x48\xf3\x67\x91\x13\x21\x77\x24\xcb\x34\xbf\xe5\xfa\xa0\xa8\x8c\xdf\xd0\xfd\x3b\x4a\x52\x2a\x96\x9a\x73\x18\xc1\x4e\xa9\xf2\x33\x53\xf1\x6f\xef\xff\x78\x83\xe2\x65\x95\xe3\xbb\x30\x44\x86\xa8\x92\x66\xf6\xcd\x13\x28\x58\x66\x44\x00\x08\xaa\x2a\x51\xe8\xe7\x78\x5b\xe3\x0f\xe5\xbc\xa3\x09\x65\xf7\x54\x58\x5d\x46\xb4\x7b\x02\xa4\x2c\xd1\x13\xcb\x19\xc4\x91\xb5\x23\x5c\x68\xa7\xd0\x4c\xe2\x23\xd4\x23\xa3\xc5\x72\xc2\xf6\x10\x7e\x82\x1f\xe0\xd1\x23\x90\x26\xba\xd3\x64\x68\x47\x50\x54\x59\x16\x7c\x95\xb7\xa6\xd8\xfe\x4f\x0e\x34\xea\x5a\x9f\xb8\x8c\xb2\xff\x8b\x92\x24\x9f\x09\x66\x86\x49\x52\x73\xa9\x9f\xae\x56\xf0\x61\xc7\x24\x6c\x58\x46\x61\x4f\x24\x6c\x29\x26\x15\x51\x34\x85\xf5\x01\xd4\x8e\x82\xdc\x93\xed\x96\x0a\x50\x9c\x67\xb1\xa6\x7f\x9e\x32\x85\xfe\xc3\x97\xcd\xb9\x9c\x6d\x77\x4a\xa7\xdc\x3d\x85\x4d\xa5\x0c\xab\x1d\x2d\xe0\xc0\x2b\x34\xe3\xb1\xa8\x0a\x8f\x53\x23\x02\x12\x9e\xe7\xa4\x48\x17\x0d\x0c\xe9\x86\x54\x99\x7a\x95\x97\x5c\x28\x89\xe1\x34\x17\xb0\x34\xb6\xdb\xb4\x3e\xa1\x39\x1e\x4b\x0c\xa6\xda\x40\xf0\xed\x3f\x01\xc4\xb5\x75\x94\x35\x39\xec\x8c\x77\xb0\x39\xc3\xf9\xea\x33\x3d\x44\x70\x65\xdc\xa7\x03\x1d\xf7\x44\xe8\x77\x06\xf3\xd0\x17\x66\x69\xcf\x4a\x44\x64\xc6\xbf\x10\x49\x75\x99\x80\xf8\x79\xbe\xa6\xa9\x84\xf8\x25\x91\x6f\x3b\x74\xbb\x08\x32\xea\xeb\x15\x98\xbc\x0a\xf4\x15\x2d\x12\x9e\xa2\xc7\x57\xba\xca\x98\x27\x8c\xbb\xbf\x15\xe3\xda\xdf\xc1\x42\xdf\x6e\x99\xda\x55\xeb\x18\xbd\xba\xda\xf2\xc7\xce\xdb\xfd\x4b\xcc\x25\x2e\x2c\xcf\x8b\xb4\x2e\x59\xe7\x11\xeb\xff\xe0\xd4\xdf\x93\x75\xb5\x21\x6c\xaa\x59\xe3\xa3\xba\x5e\xad\x6c\x4a\x9a\x3c\x7d\xc6\x64\x22\x58\xce\x0a\xa2\x38\x42\x0d\x9a\x42\x08\x8a\x66\x99\xc4\x1c\x63\xc9\x0e\xf8\xc6\x24\x97\xa9\x9b\x98\x98\x88\x2b\x60\x36\xdf\x76\x0c\xd3\x4c\x24\xbb\x03\x10\x0b\x0b\xd0\x09\xcb\x45\xbc\x50\x3a\x1e\x28\xe0\x69\x46\xa4\x74\xd0\xc1\xc0\x52\xb1\x21\x09\x35\xf8\x43\x25\x3c\xe9\x0e\x8e\xd2\x30\xb6\xcc\x9c\xe0\x73\x7a\xea\xd2\xec\x2b\x87\xac\x3d\xea\x65\xe8\xca\x11\xbe\xf8\x48\x32\x96\x22\x2e\x96\x78\x2c\x27\x4a\xea\x37\x9b\x5c\x21\xea\xb7\x0c\x2f\x0f\x21\x98\x00\xf6\xe1\xd0\x4f\x1e\xab\xf5\xaf\x54\x9d\x96\x85\xbe\xf2\x7e\x93\xea\x74\x6d\xcd\xb9\x8a\x5f\x56\x08\xcb\x9e\x6f\x90\xf3\x18\x5b\xd4\xdd\x6b\x83\x3d\x20\xd8\xca\x82\x6f\x31\xe2\x55\xa2\x1a\x17\xb7\x1a\x0c\x25\xf4\x3c\x84\x8d\x4c\xd2\x6c\x13\x9d\x44\x95\x7e\x51\xc8\x5c\x57\x1e\xa6\x80\x6a\x2c\xd9\x12\x24\x8d\x88\x26\xa5\x9a\xd0\x7a\x82\x2d\x09\x06\xb6\x75\x9c\xc5\xa2\xc9\x4b\xad\xbc\xb3\xd3\x4b\xcb\xb6\x4d\x2f\xdc\x95\x6e\xe0\xb1\xb9\x70\xfd\xfe\x71\x30\xd2\xd3\xef\x3a\x2e\x63\x41\x3a\x1e\xb1\x9b\x96\x99\x2e\x7f\x83\x71\xc4\x54\xaf\xce\x81\xa7\xd8\x18\x67\xf8\x5f\xc5\x7c\x53\x15\x09\x9c\x36\x62\x43\xdc\x77\x6e\x38\x2b\x37\x0c\xa8\x5c\x4b\x9b\xd1\xc8\x16\xbd\x58\x34\x7d\x4c\xbb\xa3\x73\xc6\x62\x12\xa1\x83\x96\xe0\x11\x7d\xb4\x25\x3b\x9a\x42\x6e\x87\xe9\x33\x19\x7a\xc6\x35\x03\xcf\x8c\x43\xdd\xf7\xc5\x45\x65\xbd\x66\x3e\xd1\x54\xac\x3f\x5e\xdb\xb9\x44\xcf\x17\xe0\x66\x14\x69\x31\x32\x62\xd0\xd0\xa1\x93\x3e\x49\x6d\xcb\x45\x4e\x7c\x8e\xba\x1e\x86\x1a\xed\xe2\xa6\xaa\x1b\xdc\xa6\x08\xdd\x3e\xb2\x71\x06\x30\xc2\xcb\x36\xaf\x81\x08\x1c\x2b\xa8\xd8\x22\x25\xca\xe3\xc0\x71\x26\xe6\xeb\x4f\x34\x51\x71\xe3\x8c\xd9\x51\xe8\xb9\x05\x63\xb0\xbc\xb9\xd5\xad\x35\xb2\xc5\x34\x34\xc1\x18\xfa\x02\xef\x46\x93\xd4\xb3\xf7\x05\xa3\x59\xaa\x67\x2e\x1c\x17\x03\x37\xb4\x3d\xe0\xd4\xac\xd0\x37\x93\xdf\x88\x4b\xef\x89\x80\x92\xe0\x84\x02\x37\xb7\xd6\x24\xa4\x74\xbb\x80\x7e\xde\xed\x02\x37\xb7\x6d\x6f\x3b\xd6\x8d\x9e\x27\x75\xf0\x6a\x44\x73\xa3\xac\x1b\xb7\xdd\x39\xa3\x89\xad\xa7\x96\xd5\x44\x37\xfa\xba\x95\xe9\xae\x65\x69\x63\xac\xaf\xeb\x87\xc9\xb9\x9e\x53\x5f\xa2\x31\x39\xfa\x61\xed\x02\x89\x7d\x98\xb4\x43\xbf\x56\x35\x76\x59\xb4\xd4\xbe\x0d\x0d\xcd\xd8\xd4\xde\x02\x1b\x1f\x45\x6e\x78\xb7\x41\x04\x17\xad\x76\xc7\x31\xb7\x91\x91\x14\xba\x40\xbb\xb3\x7a\x9c\x8a\x71\x43\x48\x88\x32\x49\x6b\x28\xe3\x38\xc6\x1d\x02\xd9\x36\xdb\x8e\xe9\x74\xd8\x49\x58\x31\x4c\xf9\x8e\x93\xaf\xb9\xa6\x3d\x05\x4c\x18\xf6\x9b\xce\xa0\xd0\x5c\x9e\x59\x35\xb2\xdb\x95\xc8\x94\x9e\xaa\x98\x59\x7c\x9a\xe4\xb3\xa5\xe7\x21\xa5\x41\xe0\xae\x05\x1b\xc1\x73\xbb\x58\xe8\x9e\x6c\xab\x83\x07\x95\x69\xa5\xdb\x7a\x64\x46\x39\xd9\xd4\xfd\x9e\x94\x3d\xce\xbc\x38\x37\xa6\x5e\x8b\xd1\xa2\xab\x6e\xfd\x73\xb5\xc9\x1f\x54\xf4\x74\x62\xe6\xd3\x8b\x85\xea\xfb\x93\x4a\xe5\xf9\x71\x29\xc8\x1e\x2c\xb2\xdd\xe4\xe7\x6a\xd5\x00\xba\x2e\x0d\x9b\x3c\x6d\x79\xe8\xf3\x11\x3c\xba\x88\xec\xf0\xc7\x59\xcb\x67\x03\x14\x53\x77\xf4\x8e\x0b\xfd\x2a\x30\xbb\x06\xd8\xa1\xa6\x17\x96\x6e\x0b\xe8\x7d\xd3\xb8\xb9\x75\x02\x8d\x49\xef\xc8\xfe\x35\x95\x12\x57\xd6\x26\xf7\xbd\x5a\xe2\x48\xcf\x96\x94\x4e\xff\xba\xdb\xb7\xa7\x5c\x66\xcc\x9b\xe9\x96\xf1\x41\x7c\xc2\xce\x6e\xf4\x1b\xfb\xbc\xa3\x07\xc0\x9e\x81\x33\x96\xfd\x89\x6f\x0d\x9d\xb1\xdd\x55\x57\x96\x86\x30\xf7\x66\x4c\x0f\xcd\x83\x0d\x49\x83\x4e\x76\xbb\x54\x31\x39\x2a\x75\x8b\x97\x41\x91\x26\x4b\xdc\xf7\x8e\x48\x0b\x60\xea\x3b\x79\x19\x47\x6a\xce\x12\x68\xc1\x35\xa1\xf1\x52\x98\x4f\x32\xc0\x78\x6c\x3f\xce\x44\xad\x1e\xed\xc7\x98\xe6\x43\x4c\x68\x10\xda\x3b\xec\x0d\x08\x5e\x3b\xb0\x3b\xb7\x61\xf9\x73\x96\x39\x21\x17\x3e\xe3\xf4\xda\x41\xbd\xe8\x50\xb4\xa6\xdd\x6e\xe2\xe6\x87\xd1\x69\xc1\x0d\x8e\x5d\x86\x0f\xbc\xd2\x2d\x22\xde\x8b\xfe\x56\x32\xcc\xfc\xc6\x13\x8d\x07\x4e\x3e\x64\x99\xc6\x64\xd1\xb0\xa6\x67\xd0\x30\x66\x9a\xa0\x12\x47\xc6\xc1\x8a\x8d\xef\x24\x26\x04\xee\xec\x86\xe5\xe4\x68\xe4\x55\xb9\xf7\xd5\x5a\x67\x26\xc2\x2a\xc1\x24\x1d\x0e\x4d\x6e\x4e\xba\x76\xfa\x18\xa1\xa8\x22\xdd\x2f\x87\xb5\xd5\x03\x83\x1b\x69\xaf\xc7\xcc\xe0\x42\xc6\xcf\x8b\x2a\x7f\x41\x58\xb6\x3c\x37\xa3\xe9\x14\x09\xd6\x3c\x3d\x04\xd1\x79\x83\xa2\xc1\x04\x36\x66\xdc\xb8\x5d\x51\xa3\x33\xd4\x61\xe3\xde\x87\x47\xd0\xfa\x65\x6e\x04\xdb\xa7\xf6\x58\x34\x28\x19\xff\x02\x8a\x84\x50\x8a\x4d\x17\x00\x00")
 
 func templatesModelGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -89,12 +153,12 @@ func templatesModelGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/model.gotmpl", size: 670, mode: os.FileMode(420), modTime: time.Unix(1437977202, 0)}
+	info := bindataFileInfo{name: "templates/model.gotmpl", size: 5965, mode: os.FileMode(420), modTime: time.Unix(1792205103, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _templatesModelvalidatorGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x56\x5f\x6f\xdb\x36\x10\x7f\xf7\xa7\xb8\x09\x19\x60\xaf\x89\xd2\x01\xc3\x1e\xd6\x65\x40\xd1\x7a\x68\x80\x2e\x0d\x9a\x6e\x2f\xc3\x80\x32\x32\x25\x73\x91\x48\x85\xa4\x9c\x78\x82\xbe\xfb\x8e\xa4\x28\x4b\xb2\xe4\x44\x0e\x06\xec\x49\xd4\x1d\xef\xee\xc7\xfb\xf3\x23\xcb\x72\x45\x63\xc6\x29\x04\xb9\x64\x19\xd3\x6c\x43\x37\x24\x65\x2b\xa2\x85\x0c\xaa\x6a\x56\x96\x2c\x86\xf0\x33\xbd\x2f\x98\xa4\x2b\x14\xe0\x2f\x95\x12\x7e\xba\x80\x7a\x1f\x6d\xb4\xf3\xb2\x0c\xaf\x89\x5e\x57\xd5\x29\x04\xb8\xfe\x28\x22\xa2\x99\xe0\x55\x15\x9c\x02\xfe\xff\x41\xd2\x82\x2e\x1f\x73\x49\x95\xb2\xe2\xc5\x1b\xeb\xeb\x9b\x0b\xe0\x2c\x85\x72\x06\x20\xa9\x2e\x24\x37\xd2\x99\x89\x4d\xf9\xaa\xc1\xf0\x1b\xe3\x1f\x29\x4f\x8c\xfb\x21\x10\x8d\x7a\x32\x0a\x2b\x6d\x79\x9f\x86\x8a\x3c\x1e\x44\xe5\xd5\x47\xa2\xda\x79\x9f\x84\x0a\x23\x69\x2a\xf9\x30\xa6\x5a\x79\x04\xa2\xaf\xce\xc4\xb9\xfe\x3a\xb5\x7a\x2c\x2b\xb2\xd1\xda\x19\xe5\x41\x44\x71\x2a\x88\xfe\xf1\x87\xf9\x60\x1f\xf9\x12\xba\x10\xf6\x6f\xf9\x18\xa5\x85\xc2\x76\x6e\xc4\x53\xeb\x7a\x00\xaf\x53\xbe\x14\xaf\x0f\xd1\xc3\xeb\xc5\xd3\xf0\x16\xa9\x66\x79\x4a\x3f\xc5\x23\x90\x1b\xfd\x4b\x51\xb7\x02\x4d\x42\xb8\xe4\x63\xe9\x34\x9a\xe3\xe6\xc3\xf9\x7c\x36\x0c\xff\xf5\x94\x17\x15\x4a\x8b\x2c\x16\x32\x23\xba\xc3\x7a\x03\x20\x7f\xb5\xbb\x9e\x48\x9f\x11\xb8\x8d\xf6\x57\x69\xc9\x78\x32\x96\x4c\x17\x57\x3d\x1b\xbd\x47\xad\x52\x16\x0d\x91\xf4\x15\xa5\x2b\x75\xc3\xfe\xa1\x56\x82\x20\x25\xc9\xae\x48\x86\xbf\x46\x68\x0e\xc3\xb8\xa9\x6d\x4a\xf9\x30\xa4\xc5\xfe\xcc\x5e\x6a\x9a\xa9\xd1\xa1\xb5\xda\xa7\x2a\xd7\xc3\xe1\x47\xb5\xf6\x3c\x75\x28\x0f\x01\xaa\xb5\x47\x01\x6a\x3c\x4f\x02\xf4\x3b\x67\xf7\x05\x3d\x80\xa9\xb5\xe1\xbf\xbd\x1d\xff\x07\xd3\x65\x60\xdc\x60\xbf\xa7\xf4\x26\x5a\xd3\x8c\xdc\x98\x3e\x05\x54\x9d\x9f\x83\xb2\x72\x50\x56\x31\x18\x71\x86\xe3\x00\xcc\x20\x7f\xfd\x06\xbf\x3f\xc3\x68\x9b\xa2\xfa\xd5\x2b\x04\x52\x96\x92\xf0\x84\x42\xe8\xf3\x0f\xe8\x18\x97\x79\x8a\xc7\x36\xef\x19\x91\x53\xa9\xb7\xbb\x49\x81\xb0\xc5\x02\x76\x95\x2a\xea\xf0\x71\xa1\xf7\x31\x5e\xd7\x1e\x5c\xaf\xbc\x30\x9e\xcb\xcf\xdb\xd5\x8a\x99\xc4\x93\x74\xe7\xa4\x39\x38\x86\xb4\x52\xbc\xf2\xab\xca\x24\x01\xb3\x60\xa7\x75\x01\x67\x5d\xa5\x11\x7c\x6f\x76\xd8\x44\x00\x3c\x0b\x09\x40\xeb\xcc\x08\x66\x34\xc1\xf0\x4b\x37\x5a\xaf\xe8\x42\xaa\xfe\x39\xae\x84\x7e\x9b\xa6\xe2\x01\xdf\x80\xc1\x90\xcb\x60\xaf\xed\x16\x83\xc4\xdc\xa7\x3a\x71\xfb\x37\x8d\xba\xd4\x8c\xc5\x72\xb4\x0d\x4e\x69\xa0\xbe\x27\x9a\x7c\xd9\xe6\xb4\x33\x00\x43\x38\x8c\xc4\x4e\xc5\xfc\x58\xf2\xdd\xcf\x6d\x53\xdb\x4b\x75\xed\x9f\xd0\x55\xd5\xad\xc7\xde\xcb\xda\xb7\x06\x16\x03\x9c\xed\x3b\x7b\x28\x77\x79\xe0\x03\xab\xeb\x61\xf8\xa2\x1a\x70\x22\xb8\x26\x88\xb3\x67\xde\xbb\x31\x86\xec\x70\x2b\x7d\xfc\x64\x33\xda\xb5\xed\x97\xc0\x18\xf7\x0b\x96\x93\xe8\x8e\xe0\x64\x58\x96\xb1\x4b\x14\x9a\x4a\x7d\x59\x33\x05\x31\xc3\xa9\x7a\x20\x0a\x12\x8a\xc8\xd0\xe9\x0a\x6e\xb7\xa0\xd7\x38\x6a\x0f\x24\x49\xa8\x04\x2d\x44\x1a\x9a\xfd\x4b\xd3\x55\x3c\x41\xa5\xb7\xcb\x58\xb2\xd6\x80\x59\xdf\x50\x88\x0b\x6d\x5d\xad\x29\x87\xad\x28\xb0\x54\x67\xb2\xe0\x1d\x4f\x3e\x04\x44\x22\xcb\x08\x5f\xcd\x66\x2c\xcb\x85\xd4\x30\xc7\xd2\x06\x09\xd3\xeb\xe2\x36\x44\xdd\x79\x22\xce\x6a\x9b\xf6\xd2\x75\x77\xf0\xac\xbd\x6b\xad\xf3\x3b\xa6\xcf\x3d\xd1\x06\x33\x4b\x08\x35\x47\xbc\xa7\x31\xc1\x97\xd2\xa5\x8d\xae\x4c\xca\xb0\x0b\xb8\x8e\x21\xf8\xf6\xde\x8f\xa3\x4f\xdf\xce\xec\xe4\x8e\x6e\x4f\xe1\x64\x63\xfa\xd6\xf4\x70\xd8\xb2\x37\x3a\x33\x8b\x25\xb4\x3d\xb9\xbd\x1d\x77\x0b\x9b\x7a\xdf\xe9\xcd\x45\xa0\x5c\x56\xb1\x46\x1f\x0a\x4c\xcd\xbb\x94\x28\x55\x33\x5b\x5c\xf0\x08\x0c\x17\x7c\xa6\x11\xc5\x3e\x95\x4e\x0e\xdf\xa1\xa8\xb5\x6f\x01\xfd\xf1\x31\x2f\x9c\x38\xd3\x68\x97\x30\x5c\x6e\x17\x8e\x1f\xec\x20\xb9\xa1\xf8\x40\x54\x6d\x84\x03\xe8\xf8\x6e\x43\x24\x96\x4e\xc1\x9f\x7f\xd9\xcd\x9d\xb4\xd5\x94\xcb\xa8\xe7\xd7\x11\x1f\x9d\x31\xef\xa2\x0e\xfd\x81\xf7\x18\x7c\x7c\xe8\xc1\x02\xba\x00\x92\xe7\x98\xc3\x39\xfe\x9c\x9a\x2d\x0b\xcb\x99\xdd\x42\xb9\x95\x83\x60\x18\x14\xf7\x1a\xc2\x7c\xdd\xf8\x69\xd3\xa4\x99\x2b\xa1\x98\xa6\x3b\xfc\x4b\xa3\x31\x56\x61\x18\xee\xfb\xaf\xcd\x11\x97\x65\x1f\x38\x89\x7c\xfa\x6d\x3b\x34\xc5\x80\xf6\x7d\xd4\x49\xda\x48\xca\x0e\xd5\x78\x17\xc4\xd4\xf8\xc9\xf4\x1d\x2e\xfa\x93\xd7\xd0\xfe\x29\xbb\x64\xf2\x6f\x00\x00\x00\xff\xff\x2f\xbf\xed\xfd\x92\x10\x00\x00")
+var _templatesModelvalidatorGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcd\x57\x51\x6f\xdb\x36\x10\x7e\xf7\xaf\xb8\x19\x19\x60\xaf\x89\xd2\x01\xc3\x1e\xd6\x65\x40\xd1\x7a\x68\x80\x2e\x0d\x9a\x6e\x2f\xc3\x80\x32\x32\x25\x73\x91\x48\x85\xa4\x92\x78\x86\xfe\xfb\xee\x48\x51\x96\x64\xd9\xb1\x63\x0c\xd8\x93\xa9\x3b\xf2\xf8\xf1\xbb\xbb\x8f\xf4\x6a\x35\xe7\x89\x90\x1c\xc6\x85\x16\xb9\xb0\xe2\x81\x3f\xb0\x4c\xcc\x99\x55\x7a\x5c\x55\xa3\xd5\x4a\x24\x10\x7d\xe6\xf7\xa5\xd0\x7c\x8e\x06\xfc\xe4\x5a\xc3\x4f\x17\x50\xcf\xe3\x8d\x77\xb2\x5a\x45\xd7\xcc\x2e\xaa\xea\x14\xc6\x38\xfe\xa8\x62\x66\x85\x92\x55\x35\x3e\x05\xfc\xfe\x83\x65\x25\x9f\x3d\x15\x9a\x1b\xe3\xcc\xd3\x37\x2e\xd6\x37\x17\x20\x45\x06\xab\x11\x80\xe6\xb6\xd4\x92\xac\x23\xda\x9b\xcb\x79\x83\xe1\x37\x21\x3f\x72\x99\x52\xf8\x21\x10\x8d\xfb\x60\x14\xce\xda\x8a\x7e\x18\x2a\xf6\xb4\x13\x55\x70\xbf\x10\xd5\x3a\xfa\x41\xa8\x70\x27\xcb\xb5\x1c\xc6\x54\x3b\x5f\x80\xe8\xab\x5f\xe2\x43\x7f\x3d\x34\x7b\x22\x2f\xf3\xad\xb9\x23\xe7\x4e\x44\x49\xa6\x98\xfd\xf1\x87\xc9\x60\x1d\x85\x14\xfa\x2d\xdc\xd7\xec\x29\xce\x4a\x83\xe5\xdc\x98\x0f\xcd\xeb\x0e\xbc\xde\x79\x2c\xde\xb0\x45\x0f\x6f\x30\x1f\x86\xb7\xcc\xac\x28\x32\xfe\x29\xd9\x02\xb9\xf1\x1f\x8b\xba\xb5\xd1\x41\x08\x67\x72\x1b\x9d\xe4\x79\x59\x7f\xf8\x98\x7b\xc3\x08\xbf\x41\xf2\xe2\xd2\x58\x95\x27\x4a\xe7\xcc\x76\x54\x6f\x00\xe4\xaf\x6e\xd6\x33\xf4\x91\xc1\x4f\x74\x9f\xc6\x6a\x21\xd3\x6d\x64\xfa\x7d\xcd\xde\xe8\x03\x6a\x93\x89\x78\x48\xa4\xaf\x38\x9f\x9b\x1b\xf1\x0f\x77\x16\x04\xa9\x59\x7e\xc5\x72\xfc\x24\x23\x1d\x46\x48\xca\x6d\xc6\xe5\x30\xa4\xe9\x66\xcf\x5e\x5a\x9e\x9b\xad\x4d\xeb\xbc\xcf\x65\xae\x87\x23\xb4\x6a\x1d\xf9\xd0\xa6\xdc\x05\xa8\xf6\xbe\x08\x50\x13\xf9\x20\x40\xbf\x4b\x71\x5f\xf2\x1d\x98\x5a\x13\xfe\xdb\xdb\xf1\x7f\xd0\x5d\x04\xe3\x06\xeb\x3d\xe3\x37\xf1\x82\xe7\xec\x86\xea\x14\xd0\x75\x7e\x0e\xc6\xd9\xc1\x38\xc7\xe0\x8e\x23\x6c\x07\x10\x84\xfc\xf5\x1b\xfc\xfd\x19\xb6\x96\x29\xba\x5f\xbd\x42\x20\xab\x95\x66\x32\xe5\x10\x05\xfe\x01\x03\xe3\xb0\xc8\xf0\xd8\xf4\x9e\x51\x05\xd7\x76\xb9\xee\x14\x88\x5a\x2a\xe0\x46\x99\xe1\x1e\x9f\x54\x76\x13\xe3\x75\x1d\xc1\xd7\xca\x91\xfb\x79\x7e\xde\xce\xe7\x82\x88\x67\xd9\x3a\x48\x73\x70\xdc\xd2\x59\xf1\xca\xaf\x2a\x22\x01\x59\x70\xdd\x3a\x85\xb3\xae\x93\x0c\xdf\xd3\x0c\x47\x04\xc0\x5e\x48\x00\x5a\x67\x46\x30\x5b\x09\x86\x5f\xba\xbb\xf5\x92\xae\xb4\xe9\x9f\xe3\x4a\xd9\xb7\x59\xa6\x1e\xf1\x0d\x38\x1e\x0a\x39\xde\x28\xbb\xe9\xa0\x30\xf7\xa5\x4e\xdd\xfe\xcd\xe3\xae\x34\x63\xb2\xbc\x6c\x83\x77\x12\xd4\xf7\xcc\xb2\x2f\xcb\x82\x37\x34\x5f\x9a\x6b\x95\x2d\x73\xa5\x8b\x85\x88\xab\x0a\x4d\x43\xa8\xda\x35\xbd\x6e\x9c\xa1\x99\x64\x71\xdd\x34\xd9\x2a\xda\x9d\xb6\x20\xaa\xab\xfe\xd3\x19\x88\xfa\x01\x2e\xf7\x78\x3e\x4f\x29\x5a\x43\x92\xcb\xe0\xd1\x80\xf7\xbc\x23\x37\x8b\xa9\xcd\x72\xf8\xcf\x50\x55\xdd\x02\xdc\xf8\x2b\x11\x7a\x81\x28\xf0\x6b\xdf\xb9\x2c\xfa\xdb\x12\x5f\x94\xdd\x08\xc3\x37\xf3\x40\x10\x25\x2d\x43\x9c\xbd\xe5\xbd\x2b\x72\x68\x1d\x4e\xe5\x4f\x9f\x5c\x09\x75\xd7\xf6\x6b\x8e\x16\xf7\xe9\x29\x58\x7c\xc7\x50\x0a\x5c\xc6\xdc\x10\x8d\x54\x9a\x5f\x16\xc2\x40\x22\x50\x46\x1e\x99\x81\x94\x23\x32\x0c\x3a\x87\xdb\x25\xd8\x05\x6a\xcb\x23\x4b\x53\xae\xc1\x2a\x95\x45\x34\x7f\x46\x6d\x24\x53\x74\x86\x75\xb9\x48\x17\x16\x90\xf5\x07\x0e\x49\x69\x5d\xa8\x05\x97\xb0\x54\x25\xa6\xec\x4c\x97\xb2\x13\x29\x6c\x01\xb1\xca\x73\x26\xe7\xa3\x91\xc8\x0b\xa5\x2d\x4c\x30\xc5\xe3\x54\xd8\x45\x79\x1b\xa1\xef\x3c\x55\x67\xf5\x9a\xf6\xd0\x97\xe0\x78\xaf\xb9\x0b\x6b\x8b\x3b\x61\xcf\xc3\xcd\x32\x1e\x39\x05\xac\x45\xf1\x3d\x4f\x18\x3e\x0d\x2f\xdd\xee\x86\x28\xc3\x2a\x90\x36\x81\xf1\xb7\xf7\x41\x7f\x02\x7d\xeb\x65\x27\x77\x7c\x79\x0a\x27\x0f\x54\xbf\x54\xcb\x51\x6b\x3d\xf9\x48\x7c\x56\xd0\x8e\xe4\xe7\x76\xc2\x4d\x1d\xf5\xa1\xe2\x9b\x9b\xcf\x78\x56\x31\x47\x1f\x4a\xa4\xe6\x5d\xc6\x8c\xa9\xa5\x3c\x29\x65\x0c\xd4\x6f\x9f\x79\xcc\xb1\x4e\xb5\xb7\xc3\x77\x68\xba\xb1\xba\x8c\xad\x37\x4c\xa1\xdf\x47\xf4\xa6\x4b\x72\x8b\x0b\x53\x81\xc3\xe5\xd4\x77\xb1\xeb\x28\xdf\x15\x1f\x98\xa9\x17\x61\x27\x7a\x85\x7f\x60\x1a\x73\x67\xe0\xcf\xbf\xdc\xe4\x0e\x6f\xb3\xfc\x16\x9f\x6d\x95\x57\x0b\xba\x86\x9a\xd6\xa8\x15\xad\xab\x4d\x27\x3d\xc8\x11\xbd\x38\x05\xcf\xe6\xf5\xe7\x7e\x42\x65\xe0\x02\x58\x51\x20\x7d\x13\xfc\x38\xa5\x29\x53\x27\x5a\x0d\xa9\x81\xdb\x36\xd4\xfa\x3e\x14\x3c\x5c\x7e\x5b\x8e\xdb\x91\xa6\x1e\xdc\x90\x9c\x8d\xeb\xf5\x58\xc0\xad\x91\x87\x40\xd7\x1b\xce\xa5\xdb\xec\xf5\x86\x42\x93\xee\x92\x06\x28\x23\x2c\x5f\xe3\x9f\x91\x87\x56\x45\x51\xb4\x19\xbf\x5e\x8e\xb8\x9c\x62\xc2\x49\x1c\x4a\xca\x95\xee\xba\x70\xa0\xfd\x5a\xe8\xb0\xb6\x85\xb3\x5d\x05\xb9\xde\x85\xea\xf1\x59\xfe\x76\x17\xe8\xb3\x8f\x84\xcd\x63\x76\x95\xef\x5f\x7a\x44\xbb\xe8\x30\x12\x00\x00")
 
 func templatesModelvalidatorGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -109,12 +173,12 @@ func templatesModelvalidatorGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/modelvalidator.gotmpl", size: 4242, mode: os.FileMode(420), modTime: time.Unix(1437978626, 0)}
+	info := bindataFileInfo{name: "templates/modelvalidator.gotmpl", size: 4656, mode: os.FileMode(420), modTime: time.Unix(1792201240, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _templatesServerBuilderGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x59\x5b\x6f\x1b\xbb\x11\x7e\xae\x7e\xc5\x40\x38\xa7\xd8\x0d\x74\xd6\x7e\x2c\x0c\xb8\x80\x1b\x9f\x22\xee\x25\x35\xec\xa0\x7d\x30\x82\x82\xde\xa5\x24\xc2\x7b\x3b\x24\xd7\xaa\x2a\xe8\xbf\x77\x86\x97\x5d\xee\x45\x8a\x14\x3b\xa8\x11\x04\x12\x39\x9c\xf9\xe6\x3e\xa4\x6a\x96\xbe\xb0\x15\x87\xdd\x2e\xb9\xb7\x1f\xf7\xfb\xd9\xec\xe2\x02\xbe\xac\x85\x82\xa5\xc8\x39\x6c\x98\x82\x15\x2f\xb9\x64\x9a\x67\xf0\xbc\x05\xbd\xe6\xa0\x36\x6c\xb5\xe2\x12\x74\x55\xe5\x09\xd1\xff\x9a\x09\x2d\xca\x15\x6e\xfa\x73\x85\x58\xad\x35\xd4\xb2\x7a\xe5\xb0\x6c\xb4\x61\xb5\xe6\x25\x6c\xab\x06\x24\xff\x45\x36\x65\x8f\x93\x17\x01\x69\x55\x14\xac\xcc\x66\x33\x51\xd4\x95\xd4\x10\xcd\x00\xe6\x4a\x4b\xe4\xae\xe6\xf4\xb9\xe4\xfa\x62\xad\x75\x6d\xbe\xac\x84\x5e\x37\xcf\x09\x1e\xba\x58\x55\xbf\x38\x66\xe1\x47\xa2\x7c\x11\xfa\x34\x62\x55\xf3\xf4\x44\x4a\x2d\x97\xc5\x89\x5c\x1d\x84\x8b\x42\x64\x59\xce\x37\x4c\xf2\xf3\xce\x29\x9e\x36\x52\xe8\xed\x7c\x86\xc7\x76\x3b\xc9\x4a\xf4\x58\x72\xcb\x97\xac\xc9\xf5\x9d\xb1\x92\xda\xef\x77\xbb\x1a\x6d\xa4\x97\x30\xff\xf9\xb7\x39\x24\xe8\x47\x22\xe6\x65\xe6\x3e\xd9\x63\x3f\xbd\xf0\xed\x02\x7e\x7a\x65\x79\xc3\xe1\xea\x1a\x92\xe0\x3c\xed\xed\xf7\x48\x0a\x21\x27\x4b\xdb\x63\x17\x9b\x10\xf9\xcc\x37\x18\x36\x37\x75\xfd\x99\x15\xb8\x7f\x73\x7f\x07\xa9\xe4\xe8\x42\x05\x0c\x4a\xbe\x81\x70\x17\x44\xa9\x34\x2b\x53\x3e\x5b\x36\x65\x3a\x71\x36\x22\xdb\xc3\x07\xfa\x3f\xb9\xad\xd2\xa6\xe0\xa5\x8e\xe1\xc3\x50\xc2\xce\xc0\x48\x1e\x78\xca\xc5\x2b\x97\x8e\x39\x2a\xf2\xfb\x01\x25\x11\x02\x10\xbb\x2b\xf0\x9f\x16\x66\x6d\x8d\xd1\x95\x73\xa9\xae\xa0\x60\x2f\x3c\x2a\x58\xfd\x64\xc3\xeb\x2b\x19\x3c\xf9\x64\xb7\x63\x4b\xbc\xac\x64\xc1\x34\xd2\x82\xf5\xb8\x37\xbb\xdd\xcd\xec\x97\x8f\x55\xa9\x10\x30\x52\xcd\x11\xc5\x6d\x7f\x71\xbf\x9f\xf7\x88\xef\x65\x95\x35\xe9\x80\xd8\x2f\x3a\xe2\x3d\x79\x5a\x72\xdd\xc8\x72\xac\xed\xcc\x66\xe8\xc8\x32\xbb\xe4\xae\x5c\x56\xc8\x51\xa5\x52\xd4\x5a\x54\x25\xd2\xea\x6d\xcd\x47\xa4\xa8\x4a\x93\x6a\x63\x4b\x63\xf5\xe0\xaf\xef\x00\x24\x48\xab\x52\xf3\xff\xe8\x8e\xa0\x8b\xe2\xe4\xa3\xdd\x9b\x75\x36\xf5\x54\x07\x8c\x3a\x6b\x0d\xda\xf2\x73\x66\x7d\xe0\x2b\x81\x1f\xb7\xb3\x91\x51\xc1\xf2\x99\x8d\x0c\xd8\x6d\xb4\x39\xd1\xd9\xdc\x1a\xe8\x63\xce\x94\xb2\x7a\xbb\x2d\x89\x66\x25\x49\x84\x95\x91\x72\x76\x11\x51\xe1\x57\x72\xc8\xdf\x79\x26\xd8\x17\xb4\x1a\xba\x02\x6b\x58\xc1\x81\x4c\x68\xa3\x6e\x8a\x9d\x4b\x52\x2f\x5a\x4e\x66\x5d\xd2\xf9\x77\x04\xcc\x6d\xf5\x81\xd5\x7e\xf1\x6c\x60\x2d\x3b\x0f\xcc\x2f\x4c\x03\x7b\x74\xb5\x05\xe3\x50\x94\x82\x82\x46\x39\x02\xb1\xc4\xe2\xa0\xfe\xc4\x94\x48\x6f\x1a\xbd\x9e\x40\x4e\xcb\x3d\xd4\x94\xda\xc4\x02\x0b\x3b\xd3\xa0\x31\xbb\x14\x34\x8a\xcb\x12\xc9\x01\x23\x00\x6a\x3c\xbb\xa9\x64\x66\xbe\xd8\xf8\xb6\xda\x8a\x32\x15\x35\xcb\x51\x30\x4a\x11\xd8\x36\xb8\xa4\x40\xc1\x4d\x94\x81\x81\x28\x52\x66\x18\x6f\xb0\x66\xc2\x33\x61\x32\x3b\x23\xed\x0d\x24\x82\x11\xd9\xe0\x58\xb8\x20\x89\x21\xa2\x52\x72\xef\x05\xed\xf7\x0b\xe0\x52\x56\x32\xee\xcc\xe2\x55\xc6\x0c\xf9\x2b\xdf\xbe\x45\x67\x86\x7d\xf1\x05\x5b\xdd\xf7\x6a\x89\x0a\x62\xab\xad\x88\x01\xb0\x5a\x00\xd6\x65\x82\xe1\x8a\x1d\xb5\x54\x91\x21\x81\xb0\x1d\x14\x77\x1e\xab\x46\xa6\xbe\x46\x1f\xb3\xc7\x29\x76\x98\x0e\x94\x7f\xd4\xd4\x9e\x6d\x7c\x8c\xac\xe2\xd2\x1b\x14\xc7\xcc\x26\x4c\x95\xa7\xf6\x95\xc1\x04\xb2\x43\xfb\xa9\xc1\x06\x1f\x9c\xee\xa8\xdb\xc0\x6b\x87\x91\x69\x39\xe1\xb8\x92\x4c\x92\x58\x25\x72\x75\x8c\xc5\xa1\x53\x23\x23\xa0\xbe\x8f\x5c\xbe\xf2\x5f\xc9\x52\x80\x03\x4e\xca\xf2\x1c\x1d\x60\xe6\x19\xf4\x11\xf7\xeb\xd2\x16\xea\x6c\x41\xaa\x4a\x4e\x4b\xcc\x97\x2d\x6f\x09\xcb\xef\xb9\xd1\x66\x12\x4a\xf1\x38\x5a\x8d\x3e\x4b\xa8\x36\x2e\xc2\x69\x8a\x42\xba\x40\xa8\xe9\x45\xe4\x47\x53\x4e\x1f\xb8\xaa\xd1\x13\xfc\x5f\x98\xba\x5c\x2e\xe0\x83\x5b\xfd\xad\xe1\x4a\xb7\x1e\xb5\x7d\xe2\x91\xeb\xdb\x61\xe1\xf4\x6e\xf2\xd0\x6a\xbf\x53\x50\x91\xb1\x85\xc5\xf4\xe9\x68\xdc\x6b\x87\x2d\x39\x9e\x90\x10\x15\xbe\x58\xb5\xf9\xb7\x9b\xfd\x6e\xc4\x2b\x19\x56\xf4\x6b\x68\x0f\x8e\xd0\xb7\xfd\xc0\x67\x54\xa8\x40\xea\x37\xdf\xa8\x80\x17\x72\xa6\x02\x2d\xb6\xb1\x02\x43\xdb\x4f\xa1\x7f\x9b\xf9\x87\xb6\x8f\x1d\x64\x42\x7c\x68\x86\x18\x5a\xbe\x0f\xf6\x07\x9a\x7a\x68\xe7\x73\xc0\xfa\x43\x0e\xec\x9f\xdd\x24\x11\x82\xf4\x95\x19\x93\xd3\xf1\x75\xf3\xc6\x19\x10\x1d\x5f\x0b\x2d\x9c\x4d\x8e\x62\xf4\x72\x2c\xb6\x07\x87\xc3\xf2\xea\xcf\x1c\x8d\xd2\x55\xe1\x70\x01\x0e\xd7\x22\x63\xba\x92\x67\x00\xec\x33\x8f\x4c\x77\xf5\xed\xce\xb1\x75\xc8\x2d\xc5\xa2\x93\xe2\x37\xfe\xe9\x17\xe2\xe9\x89\xda\xab\x93\xdc\x64\x99\x11\xe0\x39\x07\xbc\x7c\x81\x71\xbc\xb8\xdf\xe1\xa1\x2b\x5c\xcf\x08\x7a\x55\xa8\xcb\x19\x4a\x7b\x29\xe8\x16\x5b\x6e\x09\xf7\x2b\x93\xd0\x94\x81\xd3\x9f\xbe\x1e\x1b\x0a\x71\x15\x9b\xcb\x58\xd9\x03\xa3\xdd\xf5\x35\x94\x22\x07\x7b\x93\xe8\x89\xb9\xc6\xb6\x5c\x63\x77\x88\xc2\xd5\x85\x19\xd3\x26\x18\xcd\x63\x33\xd2\x7f\x63\x30\x3c\x0d\x5c\x3b\xde\xbd\x15\x9c\x67\x74\x0c\xdc\xa1\xe1\xf0\x04\x9c\x66\xf0\x78\x2b\x46\x62\x72\x0c\x5f\x38\x93\x9c\x06\xcb\x77\xff\xb7\x22\x73\x7c\x46\xe0\x2c\x8a\x9c\x97\xbd\xe3\x31\xfc\x11\x2e\x9d\x30\x57\x40\x28\x09\x4d\x67\x5f\x46\xf3\x42\x28\x45\xa5\x2a\xcc\x98\x2b\xf8\x59\xcd\xfd\xf4\xaa\x92\xbf\x54\xa2\x1c\x22\xc2\x7f\x71\x3c\xb8\x2c\xa2\x52\x98\x95\xbd\x79\x05\x6b\x00\xac\xa8\xe1\x33\x97\x38\xe1\x44\xc6\x60\x85\xb6\x2a\x83\x79\x4d\x64\x67\x35\xce\x40\x4a\xd4\x32\xb9\xbb\x6d\xbb\xe6\x99\x33\x8b\x31\xd2\xc1\x1a\xdb\x89\xb3\x4a\xde\x74\x63\x73\x25\x55\xab\x28\x15\x1a\xd6\xdb\x6a\xa7\x4f\xba\xdd\x8a\xa5\xa0\xf6\xe0\x62\x1b\x54\xba\xe6\xd4\x54\x4e\xd7\x7a\x24\x36\x72\x3c\xc2\x6b\xaf\xb9\x47\xfb\x04\x7a\x34\xfb\xf1\xf0\x5a\x4c\xd7\xb3\x1e\x33\x57\x8c\x69\x02\x3e\x94\x7b\x92\x2b\xea\xc2\x57\xd7\x93\xaf\x17\x23\x8e\xb1\xbd\x72\x83\xad\xe1\x16\x27\x1d\xb6\x19\xe4\x71\xbb\xc7\x12\x9c\x3d\xd3\xb5\x21\x75\x2b\x27\xd4\x02\xfa\x4b\xf1\xbe\x62\x32\xc4\x1a\x69\x7e\x35\xf3\xb7\xfb\x89\x6b\xa4\x55\xe0\x89\xa4\x7c\xc5\x6c\xf3\x7e\x48\x5a\x92\xc8\x7a\xa2\x59\x40\xdd\xdd\xde\x44\x89\x41\xb3\x64\x29\xdf\xed\xbb\x58\x39\x1c\x29\xe3\x3a\x62\xf8\xc5\xfb\xb8\x2b\x23\x7d\x84\xe1\xad\xef\x10\xc4\x8e\xc6\x79\xdc\x28\xec\xcd\x9a\xdc\x95\x0b\x1b\xef\x78\xfb\x7b\x4f\xe4\xc8\x2e\x86\x21\xf2\xf0\xdb\xde\x55\x21\xc7\xd4\xc2\x0f\x6e\x40\xfd\xfa\xd0\x9d\xb5\xfd\xdb\xb7\xa9\x7e\x02\xf9\xa7\x91\xa9\xdc\xe9\x26\xbf\x73\xd2\x26\x94\xd3\xcd\xd7\xaa\xed\xd9\x93\xe9\xd1\x36\xe3\x2e\x33\x7a\xfd\xfc\xdb\xe9\xe0\x39\xf8\x4c\xf8\xf7\x02\x0a\xdd\xa5\x40\x00\xa4\x97\x05\x85\x1e\xe7\x40\x4f\x72\x6f\xe7\x26\xcf\xb1\x38\x09\x9c\x51\xfe\x8b\x0a\x8e\x13\x23\x7c\xbc\xe9\xb2\xc3\xc5\xd9\x90\x80\x62\xee\xd4\x21\x65\x22\x1a\xde\x33\x36\xfc\x94\xd0\x8f\x0d\xff\x3a\xf5\x7e\xb1\x11\xca\x39\x39\x36\xda\x59\xc8\xc7\x46\x7f\x9a\xfa\x76\x68\x78\x06\xef\x10\x1a\x3d\xc9\xff\xdf\xd0\x08\x1e\xfc\x7e\x64\x68\xb8\x11\x28\x18\x2f\xc2\x97\xde\x36\x32\xda\xd7\xaa\xef\x1c\x31\x3a\x31\x93\xf3\x45\x14\x0a\x5d\xc0\x73\x55\xe5\x76\x88\x98\x1c\x06\xdb\x67\xea\xde\xfc\xd7\x29\x89\xf5\x9b\xa1\xea\xce\x2e\xeb\x05\x60\x21\xbf\x9a\xb2\xb8\x67\xf4\x14\x60\xfa\xda\xd9\xcb\x9c\x24\x3b\x9d\xae\x27\x35\x55\xa7\xc6\x47\x86\xfd\x24\x3a\xa2\x86\x7f\x93\xef\x69\x71\x84\x0c\x82\x37\xfb\xcf\x7c\xf3\x50\x35\x9a\x3d\xe7\xdc\x3d\xdf\x8f\xe1\x25\xe6\xc7\x92\x31\xc7\x05\x89\xeb\x46\x5e\x2a\xc6\x83\x11\xfc\x98\xc9\x8f\xff\xdc\x72\x64\xae\x1f\x3c\x09\x1e\x15\xf3\x14\x8c\x21\x2e\x59\xba\x97\x42\xfb\xab\x53\x90\x2a\x13\xaa\x3b\xa3\x4d\x68\x3f\x7d\x95\x88\xfb\x09\x73\x3a\xb2\x1f\x08\x66\xe2\x15\x37\xcc\x5c\x33\x46\x07\xbf\xd5\x91\x1f\xda\x5b\x81\xae\x70\xde\xa1\x7d\x4a\x5e\xfa\xb1\xa8\x42\x99\xf0\xe9\xcb\x97\x7b\x3a\x4a\xcf\x95\xcf\x9c\x1e\xf5\x33\xc8\x84\xe4\xa9\xce\xb7\x74\xb7\x37\xae\xfc\x1b\xdd\x4d\xca\x9b\x32\x33\x02\xa2\xf9\xd5\x1f\x2e\x2f\x2f\xf1\x9a\xc2\x6a\x61\x47\xf7\x08\xef\x2b\x67\x5e\x2e\x30\x0d\x7a\x65\x65\xd7\xdd\xb0\x0e\x9b\x3a\xa6\xcc\xb8\x3c\x98\x17\xe3\x54\xfb\xd6\x4f\x6e\xde\x11\x34\x01\xba\x93\x11\x3d\x7f\xfc\x2f\x00\x00\xff\xff\x11\x72\x87\xb2\x3f\x1f\x00\x00")
+var _templatesServerBuilderGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc5\x5a\x5b\x6f\xdb\x38\x16\x7e\x5e\xff\x8a\x03\x63\x66\x47\x2a\x5c\x25\x98\xa7\x85\x81\x0c\x90\x6d\x66\xd0\xec\xa5\x0d\x92\xee\xcc\x43\x50\x2c\x18\x89\xb6\x89\xc8\x92\x86\xa4\xe2\xcd\x1a\xfe\xef\x73\x0e\x2f\x12\x75\xb1\x6b\x37\x29\xa6\x28\x0a\x99\x3c\x3c\x97\x8f\xe7\x46\xb2\x15\x4b\x1f\xd9\x92\xc3\x76\x9b\xdc\xd8\xcf\xdd\x6e\x32\x39\x3b\x83\x4f\x2b\xa1\x60\x21\x72\x0e\x1b\xa6\x60\xc9\x0b\x2e\x99\xe6\x19\x3c\x3c\x83\x5e\x71\x50\x1b\xb6\x5c\x72\x09\xba\x2c\xf3\x84\xe8\x7f\xce\x84\x16\xc5\x12\x27\xfd\xba\xb5\x58\xae\x34\x54\xb2\x7c\xe2\xb0\xa8\xb5\x61\xb5\xe2\x05\x3c\x97\x35\x48\xfe\x56\xd6\x45\x87\x93\x17\x01\x69\xb9\x5e\xb3\x22\x9b\x4c\xc4\xba\x2a\xa5\x86\x68\x02\x30\x55\x5a\x22\x77\x35\xa5\xef\x82\xeb\xb3\x95\xd6\x95\xf9\xb1\x14\x7a\x55\x3f\x24\xb8\xe8\x6c\x59\xbe\x75\xcc\xc2\x4f\xa2\x7c\x14\xfa\x38\x62\x55\xf1\xf4\x48\x4a\x2d\x17\x6b\x7d\x92\x0a\x67\x6b\x91\x65\x39\xdf\x30\xc9\x4f\x5b\xa7\x78\x5a\x4b\xa1\x9f\xa7\x13\x5c\xb6\xdd\x4a\x56\xe0\x8e\x25\x57\x7c\xc1\xea\x5c\x5f\x1b\x94\xd4\x6e\xb7\xdd\x56\x88\x91\x5e\xc0\xf4\xfb\xdf\xa7\x90\xe0\x3e\x12\x31\x2f\x32\xf7\x65\x97\x7d\xf7\xc8\x9f\x67\xf0\xdd\x13\xcb\x6b\x0e\xf3\x0b\x48\x82\xf5\x34\xb7\xdb\x21\x29\x84\x9c\x2c\x6d\x87\x5d\x6c\x5c\xe4\x03\xdf\xa0\xdb\x5c\x56\xd5\x07\xb6\xc6\xf9\xcb\x9b\x6b\x48\x25\xc7\x2d\x54\xc0\xa0\xe0\x1b\x08\x67\x41\x14\x4a\xb3\x22\xe5\x93\x45\x5d\xa4\x23\x6b\x23\xc2\x1e\xde\xd0\xbf\xc9\x55\x99\xd6\x6b\x5e\xe8\x18\xde\xf4\x25\x6c\x8d\x1a\xc9\x2d\x4f\xb9\x78\xe2\xd2\x31\x47\x43\xfe\xda\xa3\x24\x42\x00\x62\x37\x07\xff\x35\x33\x63\x2b\xf4\xae\x9c\x4b\x35\x87\x35\x7b\xe4\xd1\x9a\x55\xf7\xd6\xbd\x3e\x13\xe0\xc9\x7b\x3b\x1d\x5b\xe2\x45\x29\xd7\x4c\x23\x2d\xd8\x1d\xf7\xb0\xdb\xd9\xcc\xfe\x78\x57\x16\x0a\x15\x46\xaa\x29\x6a\x71\xd5\x1d\xdc\xed\xa6\x1d\xe2\x1b\x59\x66\x75\xda\x23\xf6\x83\x8e\x78\x47\x3b\x2d\xb9\xae\x65\x31\xb4\x76\x62\x23\x74\x80\xcc\x36\xb9\x2e\x16\x25\x72\x54\xa9\x14\x95\x16\x65\x81\xb4\xfa\xb9\xe2\x03\x52\x34\xa5\x4e\xb5\xc1\xd2\xa0\x1e\xfc\xe9\x6e\x00\x12\xa4\x65\xa1\xf9\xff\x74\x4b\xd0\x7a\x71\xf2\xce\xce\x4d\x5a\x4c\x3d\xd5\x1e\x50\x27\x0d\xa0\x0d\x3f\x07\xeb\x2d\x5f\x0a\xfc\x7c\x9e\x0c\x40\x05\xcb\x67\x32\x00\xb0\x9d\x68\x62\xa2\xc5\xdc\x02\xf4\x2e\x67\x4a\x59\xbb\xdd\x94\x44\x58\x49\x12\xe9\xca\xc8\x38\x3b\x88\x5a\xe1\x4f\xda\x90\x7f\xf3\x4c\xb0\x4f\x88\x1a\x6e\x05\xe6\xb0\x35\x07\x82\xd0\x7a\xdd\x18\x3b\x17\xa4\x5e\xb4\x1c\x8d\xba\xa4\xdd\xdf\x81\x62\x6e\xaa\xab\x58\xe5\x07\x4f\x56\xac\x61\xe7\x15\xf3\x03\xe3\x8a\xdd\xb9\xdc\x82\x7e\x28\x0a\x41\x4e\xa3\x1c\x81\x58\x60\x72\x50\x7f\x67\x4a\xa4\x97\xb5\x5e\x8d\x68\x4e\xc3\x1d\xad\x29\xb4\x89\x05\x26\x76\xa6\x41\x63\x74\x29\xa8\x15\x97\x05\x92\x03\x7a\x00\x54\xb8\x76\x53\xca\xcc\xfc\xb0\xfe\x6d\xad\x15\x45\x2a\x2a\x96\xa3\x60\x94\x22\xb0\x6c\x70\x49\x8e\x82\x93\x28\x03\x1d\x51\xa4\xcc\x30\xde\x60\xce\x84\x07\xd2\xc9\xcc\x0c\xac\x37\x2a\x91\x1a\x91\x75\x8e\x99\x73\x92\x18\x22\x4a\x25\x37\x5e\xd0\x6e\x37\x03\x2e\x65\x29\xe3\x16\x16\x6f\x32\x46\xc8\x3f\xf9\xf3\x4b\x6c\x66\x58\x17\x1f\xb1\xd4\x7d\xad\x95\x68\x20\x96\xda\x92\x18\x00\xab\x04\x60\x5e\x26\x35\x5c\xb2\xa3\x92\x2a\x32\x24\x10\xb6\x82\xe2\xcc\x5d\x59\xcb\xd4\xe7\xe8\x43\x78\x9c\x82\xc3\x47\x5a\xfc\xe3\x57\x63\x80\xaa\xa7\xe8\xf1\xea\x30\x16\x66\xdc\xf4\x01\x69\x59\xe1\xb2\x25\xfa\x25\xf5\x19\xba\x34\xa3\x66\xed\x49\x78\x95\x34\xf7\x23\x3c\x70\x4c\x50\xd2\xc9\xee\x23\x26\xf9\xef\x35\x57\x7a\xd6\xfc\x10\x12\x27\x9d\x06\xb8\x0e\xd2\x15\x4f\x1f\x71\x88\x2d\x19\xd5\x2d\x43\xe7\x35\x2b\x0b\xae\xbe\x0a\xe7\xfb\xcf\xde\x27\xfb\x88\x8f\x87\xe6\xc7\x8a\x1a\x22\x1b\x91\x83\x3d\x70\x09\x15\x14\xc7\x5c\x4a\xea\x95\x9e\xda\xe7\x62\x93\x3a\x9c\x7f\xbc\xaf\xb1\xa5\x0a\x56\xb7\xd4\x4d\xa8\x37\xed\xdf\xb8\x9c\xb0\x41\x4c\x46\x49\xac\x11\xb9\x3a\xc4\x62\xdf\xaa\x01\x08\x68\xef\x1d\x97\x4f\xfc\x67\x42\x0a\xb0\xa5\x4c\x59\x9e\x23\xf8\xa6\x83\xc4\x5d\xe6\x7e\x5c\xda\xd2\x98\x99\xbd\xc4\x9d\x13\xe4\x5d\xae\x50\x78\x24\x2c\xbf\x87\x5a\x9b\xde\x33\xc5\xe5\x88\x1a\x7d\x4b\x28\x37\x2e\xa7\x50\xdf\x8a\x74\x81\x50\x53\xfd\x69\x47\x4d\x01\xbb\xe5\xaa\xc2\x9d\xe0\xbf\x61\xb2\xe4\x72\x06\x6f\xdc\xa8\x73\x24\xb7\xa3\xb6\x32\xdf\x71\x7d\xd5\x2f\x55\x7e\x9b\xbc\x6a\x95\x9f\x59\x53\x5a\xb7\xa9\xdc\x74\x46\xd1\xb0\xbb\xe9\x37\x41\xf1\x88\x84\x68\xed\xcb\x43\x93\xf1\xb6\x93\xbf\x0c\x78\x25\xfd\x1a\x7a\x01\xcd\xc2\x81\xf6\x4d\x05\xf6\x71\x1b\x1a\x90\xfa\xc9\x17\x1a\xe0\x85\x9c\x68\x40\xa3\xdb\xd0\x80\x3e\xf6\x63\xda\xbf\x0c\xfe\x3e\xf6\xb1\x53\x99\x34\xde\xd7\xb5\xf5\x91\xef\x2a\xfb\x0d\xa1\xee\xe3\x7c\x8a\xb2\x7e\x91\x53\xf6\x17\xd7\xbb\x85\x4a\xfa\x3a\x40\x09\xd4\xf2\x75\x1d\xde\x09\x2a\x3a\xbe\x56\xb5\xb0\x1b\x3c\xa8\xa3\x97\x63\x75\xbb\x75\x7a\x58\x5e\xdd\x2e\xaf\x56\xba\x5c\x3b\xbd\x00\x8f\x33\x22\x63\xba\x94\x27\x28\xd8\x65\x1e\x99\x7e\xc6\x27\x73\xc7\xd6\x69\x6e\x29\x66\xad\x14\x3f\xf1\xab\x1f\x88\xc7\xcf\x30\xde\x9c\xe4\x32\xcb\x8c\x00\xcf\x39\xe0\xe5\x13\x8c\xe3\xc5\xfd\x0c\x0f\xb7\xc2\xd5\x8c\xa0\x3b\x08\x6d\x39\xc1\x68\x2f\x05\xb7\xc5\xa6\x5b\xd2\xfb\x89\x49\xa8\x8b\x60\xd3\x7d\x55\x1b\x6f\xc3\x71\x14\x8b\xcb\xd0\xd8\x3d\xcd\xf4\xc5\x05\x14\x22\x07\x7b\x76\xeb\x88\xb9\xc0\x46\xa8\xc2\xea\x10\x85\xa3\x33\xd3\x18\x8f\x30\x9a\xc6\xe6\x10\xf5\x85\x56\xfc\x38\xe5\x9a\x86\xfa\xa5\xca\x79\x46\x87\x94\xdb\xd7\x8e\x1f\xa1\xa7\x69\x41\x5e\xaa\x23\x31\x39\xa4\x5f\xd8\x93\x1c\xa7\x96\xaf\xfe\x2f\xd5\xcc\xf1\x19\x28\x67\xb5\xc8\x79\xd1\x59\x1e\xc3\x4f\x70\xee\x84\xb9\x04\x42\x41\x68\x2a\xfb\x22\x9a\xae\x85\x52\x94\xaa\xc2\x88\x99\xc3\xf7\x6a\xea\xcf\x0b\x2a\xf9\x47\x29\x8a\xbe\x46\xf8\x37\x8e\x7b\xc7\x73\x34\x0a\xa3\xb2\xd3\xaf\x60\x0e\x80\x25\x15\x7c\xe6\x02\x27\xec\xc8\x18\x2c\x11\xab\x22\xe8\xd7\x44\x76\x52\xe1\x0c\xa4\x44\x0d\x93\xeb\xab\xa6\x6a\x9e\xd8\xb3\x18\x90\xf6\xe6\xd8\x56\x9c\x35\xf2\xb2\x6d\xbc\x4b\xa9\x1a\x43\x29\xd1\xb0\xce\x54\xd3\x7d\xd2\x7d\x82\x58\x08\x2a\x0f\xce\xb7\xb1\xd1\x5e\x71\x2a\x2a\xc7\x5b\x3d\x10\x1b\x39\x1e\xe1\x45\x83\xb9\xb9\xf0\x01\x74\x67\xe6\xe3\xfe\x45\x04\x1d\x88\x3b\xcc\x5c\x32\xa6\x0e\x78\x5f\xec\x49\xae\xa8\x0a\xcf\x2f\x46\xef\x8b\x06\x1c\x63\x7b\xc9\x01\xa6\x48\xe0\x22\x1b\x39\x5e\x5f\x77\x2d\x85\x3d\x67\xba\xb2\x24\x76\xe4\x88\x1c\x40\x7f\x52\x3c\xe9\x98\xc8\xb0\xe0\x4c\xe7\x13\x7f\x8f\x32\x72\x60\xb7\x8a\xdf\x93\x94\xcf\x18\x65\x1e\xff\xa4\x21\x89\xec\x0e\xd4\x33\xa8\xda\x73\xb2\xc0\x53\x8e\x5c\xb0\x94\x6f\x77\xad\x8f\xec\xf7\x90\x61\xfe\x30\xfc\xe2\x5d\xdc\xa6\x8f\xae\x86\xe1\xf9\xda\xc2\x42\x30\x39\x80\xac\xb6\xcd\x9a\x7d\x26\xb4\x3c\x9c\x27\x18\x40\x66\x8e\x4b\x72\x5d\xcc\x6c\x1c\xe0\xf9\xef\x35\x2d\x43\x76\x31\xec\xb7\xcc\x9f\x98\xf7\x02\x6f\x0e\xa5\x0d\xf2\x07\xb4\xeb\x9f\x15\x5f\x43\xcf\xf0\xd7\xce\x65\x51\xc7\xd4\x2a\x1c\x9c\xe0\xba\xf9\xad\x5d\x6b\xfb\x0f\x5f\x66\xbb\x09\xc0\x5f\xa6\x8d\xc5\x7e\xdb\xb9\x9e\x12\xf6\xa1\x9c\xf6\x7c\xa0\x1a\x74\x46\xc3\xbb\x69\x26\xda\xc8\xee\xf4\x23\x5f\x0e\x67\xcf\xc1\x47\xf2\x7f\x67\xb0\xd6\x6d\x28\x07\x8a\x74\xa2\x79\xad\x87\xb1\xdc\x91\xdc\x99\xb9\xcc\x73\x4c\xae\x02\x7b\xac\xff\xa3\x81\xc3\x00\x0f\xaf\xfb\xe6\xfd\x78\xe8\x13\x90\x97\x1d\xdb\x64\x8d\x78\xc3\x6b\xfa\x86\xef\x72\xba\xbe\xe1\xef\x33\x5f\xcf\x37\x42\x39\x47\xfb\x46\xd3\xcb\x79\xdf\xe8\x76\x83\x5f\x76\x0d\xcf\xe0\x15\x5c\xa3\x23\xf9\xcf\x75\x8d\xe0\x8a\xf8\x5b\xba\x86\x6b\xe1\x82\xf6\x28\x7c\x1b\x68\x3c\xa3\xb9\xad\xfb\xca\x16\xa9\x15\x33\xda\x1f\x45\xa1\xd0\x19\x3c\x94\x65\x6e\x9b\xa0\xd1\x66\xb6\x79\xd8\xe8\xf4\xaf\xad\x91\x58\x67\x18\x9a\xee\x70\x59\xcd\x00\x53\xfa\xfc\xe2\x00\xa3\xfb\x40\xa7\xcf\x2d\x5e\x66\x65\x9b\x5e\xcd\x93\x4b\x78\xcc\x6e\x5f\x5d\x9a\x17\x99\x72\x61\x66\xd0\xe6\xd9\xc4\xde\x8f\xe2\xcc\x42\x2c\x6b\x69\xce\x83\xd4\xc5\x42\x2e\x1e\x69\x81\x54\xe8\xa7\x1c\x01\x48\x95\xbb\x88\xb5\x9d\xa1\x3f\x40\x12\xc6\x1e\x7e\xdf\xac\x5a\xe9\xf6\xc9\xd7\xb4\x81\xa7\x25\x6e\x52\x11\x4f\x8e\x23\xcf\x45\xfb\xc1\xf6\x96\x75\xb0\x3e\x40\x16\xa0\x92\x7c\xe0\x9b\xdb\xb2\xd6\xec\x21\xe7\x5e\xfa\x70\xa5\x79\x04\x1c\x72\x9c\x91\xb8\xb8\xeb\xdb\x7b\xc5\xd2\x26\x1d\x8f\x04\x75\x70\xce\xd7\xde\x31\x6c\x4e\xa2\x7d\xc7\xff\x16\x32\xdc\xcb\xac\x2c\x7e\xd0\xb4\x23\x18\x27\x74\x15\x4e\x5c\x7c\x8d\x75\x2f\x6e\xb6\xb2\xf5\xce\x63\x87\xfc\xf7\xf0\x6b\xe7\x81\x43\x5e\xef\x7e\xf8\xa0\x98\xfb\xa0\x37\x75\x99\xa7\xbd\x36\xb6\x8f\xbe\x41\xde\x89\xf6\x82\x3c\x3b\xf6\x5c\x19\x77\xb3\xcf\xf1\x9a\x7d\x43\x65\x46\xae\xf4\xc3\x34\x68\x82\x29\x78\x2a\xa7\x7d\x68\xa2\x0e\x37\x5b\x99\x79\x17\xda\x50\xa2\x4c\x78\xff\xe9\xd3\x0d\x2d\xa5\xbb\xeb\x07\x4e\x6f\x6a\x19\x64\x42\xf2\x54\xe7\xcf\x74\xd1\x63\xb6\xf2\x5f\x74\x50\x2d\x2e\x8b\xcc\x08\x88\xa6\xf3\xbf\x9d\x9f\x9f\xe3\x99\x95\x55\xc2\x9e\xe3\x22\x3c\xbc\x9e\x78\xd2\x44\x77\xec\xe4\xe8\x6d\x7b\xdc\xde\x0f\x75\x4c\x01\x7c\xbe\x37\x7c\x87\x21\xf1\xa5\x17\x6f\xbf\x11\xd4\xf6\xbb\x95\x51\x1c\xa2\xf9\x9b\xd0\xab\xab\x12\x93\xdb\xc9\xa8\xfa\xd4\x89\x49\xdc\xd1\x28\xff\x5f\x52\xde\xd6\xa2\x49\x8a\xb4\x86\xd9\x10\xcc\x48\x4e\xc5\xe8\x1d\x92\xe7\xe5\xc6\x8c\xd1\x03\x94\x19\x3b\x15\x5f\xaf\xf8\x18\xce\xab\xf1\x42\xe2\xf7\x05\xf5\x36\xff\xd9\xc6\x9c\x11\x4d\xaf\xa0\x7a\x29\xc2\xc1\x19\x24\xc9\x3b\x6b\xd9\x7f\xae\xa3\xb1\xc1\x8f\x95\x56\x5b\x3c\x1c\xf2\x1b\xb4\x64\x7e\x60\x1b\x3c\x4d\x14\x63\xea\x5c\xd1\x4e\xfc\x01\xe3\x57\xd6\x95\x48\x24\x00\x00")
 
 func templatesServerBuilderGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -129,12 +193,12 @@ func templatesServerBuilderGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/builder.gotmpl", size: 7999, mode: os.FileMode(420), modTime: time.Unix(1436944861, 0)}
+	info := bindataFileInfo{name: "templates/server/builder.gotmpl", size: 9288, mode: os.FileMode(420), modTime: time.Unix(1792204871, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _templatesServerConfigureapiGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x54\x5d\x6f\x9c\x3a\x10\x7d\xdf\x5f\x31\x42\xb9\xba\x10\xed\x25\xef\x57\xca\x43\x9a\xb6\x6a\x54\x35\x89\xb2\x95\x2a\xf5\xcd\x0b\x03\xb8\x0b\x36\xb5\x4d\x36\x5b\xe4\xff\xde\x31\x86\x85\xdd\x4d\xf3\xd9\xbe\xf5\x09\x33\x9e\xaf\x73\xce\x8c\x6b\x96\xac\x58\x8e\x50\x31\x2e\x66\x33\x5e\xd5\x52\x19\x08\x67\x00\x41\xce\x4d\xd1\x2c\xe3\x44\x56\x27\xb9\xfc\x4f\xaf\x59\x9e\xa3\x9a\x1e\x51\x29\xa9\x74\xf0\x24\xdf\xc2\x98\x7a\xc5\x4d\x30\x23\xef\xb6\x55\x4c\x50\xc9\xf8\x2d\x66\xac\x29\xcd\x45\x57\x54\x5b\xdb\xb6\xb5\xe2\xc2\x64\x10\xfc\xf3\x3d\x80\xd8\xda\xce\x19\x45\xda\x9f\x7c\xd8\xd1\x0a\x37\x73\x38\xba\x65\x65\x83\xf0\xff\x29\xc4\x93\x78\x77\x67\x2d\xb9\xc2\x34\x93\xf7\xdd\x49\x17\xcd\x66\x27\x27\xf0\xb9\xe0\x1a\x32\x5e\x22\xd0\x57\xb3\x0c\xc1\x48\xc0\x94\x9b\x18\xae\x44\x42\x56\x03\x78\xc7\xb5\xd1\xee\xb4\x96\xe2\x5f\x03\x4b\x04\x79\x8b\x6a\xad\xb8\x31\x48\x8c\x65\x8d\x48\x20\x91\x22\xe3\x79\xa3\xf0\xec\xfa\x22\x64\x35\x87\xe3\xb6\x8d\xaf\x3d\xb3\xd6\xc6\xf4\x73\x56\xd7\x97\xac\xa2\x1f\xf2\x88\xa0\xa5\x4e\xa8\xfc\x36\x0c\x4c\x81\xe0\xe2\x0a\x54\x48\x77\x74\x8c\x17\xa8\x6e\xf1\x9d\xa3\x18\x4e\xc1\x53\x3d\xb1\xed\xf0\x78\x2e\x85\x6e\x2a\xec\x18\xe0\x59\x47\x48\x89\x15\x0a\xc3\x0c\x97\xc2\x5a\x97\x8e\x7a\x38\x2f\x99\xd6\xbe\x8b\x3e\xc2\xa5\xa6\x8b\x7d\xff\x30\xf2\x4c\x95\x1a\x1f\x09\xee\x65\x1d\x3a\x50\xef\x89\x8d\xd0\x51\x12\x2a\xe0\x32\xbe\x41\x96\xa2\x9a\x83\x61\x2a\x47\x03\xa4\x08\xaa\x8c\x25\xd8\xda\xc8\x43\xea\x98\x00\x50\x68\x1a\x25\x06\x94\x97\xd2\x6c\x3b\xc2\x34\x0c\xa8\xba\x2f\xec\x08\xf3\x95\x0b\xa6\x41\x48\x03\x1b\x74\x8a\xa0\x00\x3e\x06\x04\xae\x7b\x1b\x4d\x07\x67\x7f\x84\xe2\x6b\x25\xd3\x26\x79\x0e\x63\x7d\xc4\xcb\x18\x9b\x04\x0f\x8c\x0d\xa6\x91\xb1\xb5\x63\xec\x0b\xcd\x95\x63\x2c\x65\x86\xbd\x9e\xaf\x7a\xa8\xfb\x5a\xbe\x16\x98\x34\xd4\xd9\x86\x36\x96\x0b\xee\x30\xeb\xde\xa1\x63\x4f\xbf\x61\x9a\x27\x67\x8d\x29\x3a\xeb\x21\x01\xee\x8a\xc0\x77\x38\x1b\x4d\x0d\x69\x43\xfb\x99\xcf\xa1\x26\x97\xfe\x27\x82\xb0\x5b\x1b\x3a\x27\xbc\x66\xa5\xb5\x73\x8f\x30\xda\x45\x2d\x78\x39\xff\x15\xf4\xa5\xeb\x03\x98\xab\xf6\x38\xe4\x11\xea\x00\x83\x96\xf3\x23\x6e\x9e\x88\xc3\xc8\x15\x65\xfd\x7d\xbd\xbb\xfd\xa7\xe7\xcb\x77\x3f\x6a\x98\x29\x59\xb9\xdf\x85\x6c\x54\xe2\x0c\xcf\x01\x76\xbf\x9a\x57\x35\x2a\xd6\x8b\xe8\xa1\x6f\xdf\xaa\x43\xc8\x1f\x98\x48\xcb\x61\xee\x77\xde\xb4\x43\xa7\x71\x98\x87\xb4\x8a\x55\x54\xa4\xee\xbe\x0f\x25\xf0\x9e\x53\x39\x28\x23\xc4\x8e\x6f\xa9\xf8\x0f\x4c\xc7\x64\xf3\x5d\xd5\x46\x17\xaa\x33\xf0\x0f\x7b\x6a\xf4\x11\x24\x93\x0f\x5a\x34\x09\x6d\xbf\xfe\x24\x53\x2c\x87\x44\x37\x9d\x44\xfa\x5c\x3a\x3a\xef\xae\x96\xdf\x30\x31\xd6\x1e\x6f\x8b\xed\x05\x6d\xdb\xb8\x4f\xe7\xfb\xab\x0c\x86\xaf\xa8\xe4\x7e\x82\xc3\x79\x90\x83\x4a\x93\x61\x78\xc2\x1e\x3f\xf8\x10\xed\x88\xf9\x12\xfd\xfe\x4a\xf6\xc7\x24\xdb\xdb\x57\x3b\xfb\x19\x00\x00\xff\xff\xf9\x55\xab\x19\x9b\x09\x00\x00")
+var _templatesServerConfigureapiGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xe5\x55\x4b\x6f\xd3\x40\x10\xbe\xfb\x57\x8c\xac\x22\xec\x2a\x38\x52\x8f\x95\x7a\x08\x05\x44\x85\xd4\x54\x0d\x12\x07\xc4\x61\x63\x8f\x9d\x55\xec\x5d\xb3\xbb\x6e\x1a\xac\xfd\xef\xcc\xfa\x51\x3b\x29\xa4\x4d\xe9\x8d\x93\xf7\x31\xaf\xef\xfb\xc6\xb3\x25\x8b\xd7\x2c\x43\x28\x18\x17\x9e\xc7\x8b\x52\x2a\x03\x81\x07\xe0\x67\xdc\xac\xaa\x65\x14\xcb\x62\x9a\xc9\x77\x7a\xc3\xb2\x0c\xd5\x78\x89\x4a\x49\xa5\xfd\x67\xd9\xae\x8c\x29\xd7\xdc\x1c\x65\x3c\x2d\x78\x92\xe4\xb8\x61\x0a\x7d\x8f\x1c\xeb\x5a\x31\x41\xa5\x46\x1f\x30\x65\x55\x6e\xae\x9a\x62\xb5\xb5\x75\x5d\x2a\x2e\x4c\x0a\xfe\x9b\x9f\x3e\x44\xd6\x36\xc6\x28\x92\x6e\xd5\xba\x9d\xac\x71\x3b\x81\x93\x3b\x96\x57\x08\xe7\x17\x10\x8d\xfc\xdd\x9d\xb5\x64\x0a\xe3\x48\xad\xed\x4e\xb8\xd0\xf3\xa6\x53\xf8\xba\xe2\x1a\x52\x9e\x23\xd0\x57\xb3\x14\xc1\x48\xc0\x84\x9b\x08\xe6\x22\xa6\x53\x03\x78\xcf\xb5\xd1\x6e\xb5\x91\xe2\xad\x81\x25\x82\xbc\x43\xb5\x51\xdc\x18\x24\xa6\xd3\x4a\xc4\x10\x4b\x91\xf2\xac\x52\x38\xbb\xb9\x0a\x58\xc9\xe1\xb4\xae\xa3\x9b\x56\x11\x6b\x23\xda\xcc\xca\xf2\x9a\x15\xb4\x21\x8b\x10\x6a\xaa\x84\xd2\x3f\xb8\x81\x59\x21\x38\xbf\x15\x2a\xa4\x3b\x5a\x46\x0b\x54\x77\xf8\xd1\x49\x03\x17\xd0\x4a\x34\x3a\xf3\xda\x08\x2c\xcf\xe5\x06\x96\x4a\x6e\x34\x2a\x88\x73\x8e\x82\x8a\x4d\x95\x2c\x40\x52\x4c\x05\x52\xf1\x8c\x0b\xed\x70\xc5\x64\xdc\x27\xea\xbc\x29\xcd\xa5\x14\x06\xef\x4d\x10\x52\x70\x73\x39\xbf\x5d\x04\x83\x5a\x91\xdb\xcf\x4b\xa3\xeb\x99\xcb\x83\xc9\xbc\x8d\x76\x0e\xdf\x7f\x68\x43\x04\x67\xb5\x7f\xea\x5b\x1b\xee\xa8\x4a\x11\x75\x55\x60\xa3\x07\x4f\x1b\x79\x72\x2c\xa8\x30\x66\xb8\x14\xd6\xba\xac\xc4\xc8\x65\xce\xb4\x6e\x39\xe9\x3c\x1c\x50\xba\xd8\xb7\x0f\xc2\x56\xb7\x5c\xe3\x13\xce\x5d\xbf\xf5\x15\xa8\x4f\xa4\x4d\xe0\x04\x0a\x14\x70\x19\xdd\x22\x4b\x50\x4d\xc0\x30\x95\xa1\x01\xea\x0f\x54\x29\x8b\xb1\xb6\x61\x4b\x70\xa3\x0b\x80\x42\x53\x29\xd1\x73\x7e\x2d\xcd\x43\x45\x98\x04\x3e\x65\x6f\x13\x3b\xf9\xda\xcc\x2b\xa6\x41\x48\x03\x5b\x74\xfd\x81\x02\xf8\xe0\xe0\xbb\xea\x6d\x38\x6e\xe3\xfd\x86\x8e\x6e\x94\x4c\xaa\xf8\x18\xc6\x3a\x8f\x97\x31\x36\x72\xee\x19\xeb\x8f\x06\xc6\x36\x8e\xb1\x6f\xd4\xe5\x8e\xb1\x84\x19\xf6\xef\x7c\x95\x7d\xde\x7f\xe5\x6b\x81\x71\x45\x95\x6d\x69\x7e\x70\xc1\x1d\x66\xdd\x19\x34\xec\xe9\xf7\x4c\xf3\x78\x56\x99\x55\x73\xfa\x98\x00\x77\x45\xe0\x1b\x9c\x95\xfb\x71\xda\x66\x9e\x40\x49\x26\xdd\x26\x84\xa0\xf9\x89\x69\x1d\xf3\x92\xe5\xd6\x4e\x5a\x84\xe1\x2e\x6a\xc1\xf3\xc9\xdf\xa0\x2f\x5d\x1d\xc0\x5c\xb6\xa7\x21\x0f\x50\x7b\x18\x34\x2a\xbe\xe0\xf6\x99\x38\x8c\x5c\x53\xd4\xd7\xab\xdd\x4d\x23\x1a\xa6\x6d\xf5\x83\x86\xcd\x68\xa1\xed\x42\x56\x2a\x76\x07\x2f\x01\x36\x77\x85\x9f\xbd\x02\xa8\x7e\x0c\x1d\x80\x77\x08\xa3\x74\xe0\xce\xa8\x6c\x9a\x75\x6a\x1f\xe9\x31\xc0\xfe\xdc\xa6\xf3\x12\x15\xeb\xba\xb3\x85\xfe\xf0\x24\x3c\x86\xfd\x99\x09\x9a\xba\xdd\x0f\xbd\xf3\x74\x3c\x36\x1a\xfe\xd2\x3e\xac\x62\x05\x25\x29\x9b\xef\xa1\x00\xad\xe5\x58\x0e\x8a\x08\x91\xe3\x9c\x5e\x8a\x5f\x98\x0c\xc1\x26\xbb\xaa\x0d\x26\x94\xa7\xd7\x00\xf6\x14\xe9\x3c\x48\xaa\xd1\x23\x72\x8b\xba\x94\xa2\x99\xbc\xc7\x36\xa1\xec\x19\x3c\x4a\x97\xc3\xd3\x6f\x87\xe8\x97\x70\xfb\x9f\xd2\xb9\xd7\xe7\xd6\xfb\x0d\x18\x2c\x07\xb8\x72\x0a\x00\x00")
 
 func templatesServerConfigureapiGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -149,12 +213,12 @@ func templatesServerConfigureapiGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/configureapi.gotmpl", size: 2459, mode: os.FileMode(420), modTime: time.Unix(1436944861, 0)}
+	info := bindataFileInfo{name: "templates/server/configureapi.gotmpl", size: 2674, mode: os.FileMode(420), modTime: time.Unix(1792204640, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _templatesServerMainGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x54\x4d\x6f\xdb\x38\x10\x3d\x5b\xbf\x62\x42\x24\x80\x8c\xb5\xe8\x3d\x7b\xe1\x83\x81\xc5\x6e\x5c\x34\x1f\x88\x13\xf4\xd2\x0b\x23\x53\x32\x1b\x9a\x54\x49\xca\xae\x61\xf0\xbf\x77\x86\x92\x1c\x35\xbd\xf4\x62\x4b\x33\x8f\x6f\xde\xbc\x19\xaa\x11\xe5\x9b\xa8\x25\xec\x85\x32\x59\xa6\xf6\x8d\x75\x01\xf2\x0c\x80\x69\x5b\x33\xfa\xb7\x3e\xfd\x19\x19\xe6\xbb\x10\x1a\x96\xd1\x5b\xad\xc2\xae\x7d\xe5\xa5\xdd\xcf\x6b\x5b\xf8\xa3\xa8\x6b\xe9\xc6\x8f\xbe\x91\x65\x82\x9e\xcf\x4e\x18\x2c\xc0\xff\x95\x95\x68\x75\x58\xa7\x12\x3e\xc6\xf3\xb9\x71\xca\x84\x0a\xd8\xcd\x77\x06\x3c\xc6\x04\x96\x66\xdb\x3f\x75\xc7\xae\xdf\xe4\x69\x06\xd7\x07\xa1\x5b\x09\x8b\x25\xf0\xd1\x79\xca\xc5\x88\x50\x18\x33\x75\xd8\x5f\xe8\xa6\x59\x36\x9f\xc3\xf3\x4e\x79\xa8\x94\x96\x70\x14\x1e\x6a\x69\xa4\x13\x41\x6e\xe1\xf5\x04\x61\x27\xa1\x57\x0e\xc1\x5a\xcd\x09\x7f\x27\xde\x30\xda\x3a\x09\xc6\x06\x0c\x83\x3d\x48\x77\x74\x2a\x48\xc4\x0f\x54\xa2\x0a\x78\xe6\x64\xdb\x11\xa1\x0a\xf0\x2a\x4b\xd1\x7a\x4c\x6b\x4d\x49\x07\x72\xab\x82\x87\xa3\x6d\x35\x16\x94\xa0\xad\x0f\x57\x54\x64\x1d\xfa\xa0\x35\xfa\x44\x99\xa1\x48\x90\x06\x54\x95\x98\xe5\x8f\x46\xab\x52\x05\x04\x90\xad\xaa\x3a\x41\x51\x28\x53\xea\x76\x2b\x0b\x1a\x1c\x54\xd6\xa5\x1e\x06\x0d\xa9\x2e\xc6\x7c\xdb\xa4\x81\xe2\x9c\xf6\xc2\x6c\x3d\x56\xac\xed\xe2\x82\x1a\x5a\x7e\x0f\x48\x87\xe5\xa1\x08\xc0\xf9\x9c\x73\x28\x56\xe8\x21\x5f\x35\xcd\xbd\xd8\x4b\xb2\x1c\x15\xf1\x47\x34\xbb\x54\x8d\xd0\xe8\x7d\x51\x34\xc3\x1b\x21\x47\xa9\xc1\xfb\xec\x20\xdc\x50\xe8\xd3\xe6\xe1\x1e\x96\xf0\xcd\x5b\xc3\x9f\xc4\xf1\x4e\x7a\x8f\xbb\x97\xe3\xc1\xcd\x3b\x20\x46\x1c\x57\xd5\x9a\x32\xed\x64\x3e\x85\x33\x4e\xb2\x27\xd8\x60\xfb\x33\x90\xce\xd1\x2a\x90\x17\xfc\x5e\x1e\xf3\x11\xfb\x0c\x18\x9b\x22\x1e\x75\x12\xea\x6a\x09\x46\xe9\xc4\x00\xe8\x79\xcd\xff\x13\x01\xad\x31\x39\x26\x09\x16\x69\x43\x93\x43\xc8\x67\x3d\xff\x5f\xa2\xed\x87\x9c\x3d\x3e\x3c\x3d\x0f\x3c\x29\xbd\x5c\x22\x71\xcf\xd3\x05\x80\xfd\xcd\x06\x86\x1d\x4e\xf3\x03\xc3\xed\xc3\xe6\xc2\x90\xd2\x63\x86\x2e\x40\x57\xac\x14\x9a\x5e\x2e\x4c\xa2\x51\x44\x44\x56\x76\x17\x33\x46\x6a\x71\x3c\x84\xd5\xe3\x3a\x1f\xd9\x41\x35\x4a\x6b\x2a\x55\xe3\xa6\x52\x0e\x29\xa6\x44\xa5\x95\x0f\x34\xd7\x8b\x5f\x78\x83\xf9\xe7\x14\xcc\x59\x28\x1b\x36\xeb\x74\xfc\x05\x6c\xc1\xf0\x97\xda\x9a\x66\x93\x8f\xce\x4d\x26\xbf\xf9\x36\x41\xa9\x93\x6a\x1f\xd2\xb8\x43\x95\x33\x5a\x1b\x65\x6a\x92\x7d\xdb\xe2\xa2\x5d\xb4\x82\x08\x40\x1f\x8d\xc5\x7c\x7e\xe3\xbf\x1a\x2c\x39\xa8\xe2\xab\xed\xd6\xe5\xd3\xa4\xb4\x2f\x89\x12\x09\xcb\x37\xb4\x84\xf9\xbb\x7c\x6c\xa8\x8b\x75\xeb\xb7\xee\x16\xff\x65\x1d\xe3\x17\xfc\x0a\xbd\xac\xfb\x4d\x43\xb2\x7f\xfe\x70\xe8\x31\xfb\x19\x00\x00\xff\xff\xc6\xe5\x03\xba\xf7\x04\x00\x00")
+var _templatesServerMainGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8d\x54\x4d\x6f\xdb\x30\x0c\x3d\xc7\xbf\x82\x15\x5a\xc0\xc1\x62\x79\xe7\x0c\x39\x04\x28\xb6\x66\x58\x3f\xd0\x74\xd8\x65\x17\xd5\x96\x1d\xad\x8a\xe4\x49\x72\xb2\x20\xf0\x7f\x2f\x29\xc7\xa9\xd7\x5d\x76\xb1\x2d\xf2\xe9\x3d\xea\x91\x72\x23\x8a\x17\x51\x4b\xd8\x0a\x65\x92\x44\x6d\x1b\xeb\x02\xa4\x09\x00\xd3\xb6\x66\xf4\xb6\x3e\xbe\x8c\x0c\xf9\x26\x84\x86\x25\xb4\xaa\x55\xd8\xb4\xcf\xbc\xb0\xdb\xbc\xb6\x99\xdf\x8b\xba\x96\x6e\xfc\xe9\x1b\x59\x44\xe8\xf1\xe8\x84\x41\x01\x7e\x2d\x2b\xd1\xea\xb0\x8a\x12\xbe\xeb\x8e\xc7\xc6\x29\x13\x2a\x60\x57\xbf\x19\xf0\xae\x8b\x60\x69\xca\xd3\x57\xbf\xed\xf2\x45\x1e\x66\x70\xb9\x13\xba\x95\x30\x5f\x00\x1f\xed\xa7\x5c\xd7\x21\x14\xc6\x4c\x3d\xf6\x2f\xba\x69\x92\xe4\x39\x3c\x6d\x94\x87\x4a\x69\x09\x7b\xe1\xa1\x96\x46\x3a\x11\x64\x09\xcf\x07\x08\x1b\x09\xa7\xca\x21\x58\xab\x39\xe1\x6f\xc5\x0b\x46\x5b\x27\xc1\xd8\x80\x61\xb0\x3b\xe9\xf6\x4e\x05\x89\xf8\x81\x4a\x54\x01\xf7\x1c\x6c\x3b\x22\x54\x01\x9e\x65\x21\x5a\x8f\x69\xad\x29\xe9\x40\x96\x2a\x78\xd8\xdb\x56\xa3\xa0\x04\x6d\x7d\xb8\x20\x91\x55\x38\x05\xad\xd1\x07\xca\x0c\x22\x41\x1a\x50\x55\x64\x96\x7f\x1a\xad\x0a\x15\x10\x40\xb6\xaa\xea\x00\x59\xa6\x4c\xa1\xdb\x52\x66\xd4\x38\xa8\xac\x8b\x67\x18\x6a\x88\xba\x18\xf3\x6d\x13\x1b\x8a\x7d\xda\x0a\x53\x7a\x54\xac\xed\xfc\x8c\x1a\x8e\xfc\x16\x90\x0e\xe5\x21\x0b\xc0\x79\xce\x39\x64\x4b\xf4\x90\x2f\x9b\xe6\x4e\x6c\x25\x59\x8e\x15\xf1\x07\x34\xbb\x50\x8d\xd0\xe8\x7d\x96\x35\xc3\x8a\x90\xa3\xd4\xe0\x7d\xb2\x13\x6e\x10\xfa\xba\xbe\xbf\x83\x05\xfc\xf2\xd6\xf0\x47\xb1\xbf\x95\xde\xe3\xec\xa5\xb8\x71\xfd\x06\xe8\x3a\x6c\x57\xd5\x9a\x22\xce\x64\x3a\x85\x23\x76\xf2\x44\xb0\xc6\xe3\xcf\x40\x3a\x47\xa3\x40\x5e\xf0\x3b\xb9\x4f\x47\xec\x33\x60\x6c\x8a\x78\xac\x93\x50\x17\x0b\x30\x4a\x47\x06\x40\xcf\x6b\xfe\x59\x04\xb4\xc6\xa4\x98\x24\x58\x47\x13\x1a\x1d\x42\x3e\xeb\xf9\x17\x89\xb6\xef\x52\xf6\x70\xff\xf8\x34\xf0\xc4\xf4\x62\x81\xc4\x27\x9e\x3e\x00\xec\x23\x1b\x18\x36\xd8\xcd\x77\x0c\x37\xf7\xeb\x33\x43\x4c\x8f\x19\xfa\x00\x5d\xb1\x42\x68\x5a\x9c\x99\x44\xa3\x88\x88\xac\xec\x2f\x66\xd7\xd1\x11\xc7\x4d\x58\x3e\xac\xd2\x91\x1d\xa4\x51\x58\x53\xa9\x1a\x27\x95\x72\x48\x31\x25\x2a\xad\x7c\xa0\xbe\x9e\xfd\xc2\x1b\xcc\xbf\xc5\x60\xca\x42\xd1\xb0\x59\x5f\xc7\x07\x60\x73\x86\x4f\x3a\xd6\x34\x99\xbc\x77\x6e\x32\xf9\xc7\xb7\x09\x96\x3a\xa9\xb6\x21\xb6\x3b\x54\x29\xa3\xb1\x51\xa6\xa6\xb2\x6f\x5a\x1c\xb4\x73\xad\x20\x02\xd0\x4f\x63\x9e\xe7\x57\xfe\xa7\x41\xc9\xa1\x2a\xbe\x2c\x4b\x97\x4e\x63\xa5\x27\x49\x2c\x91\xb0\x7c\x4d\x43\x98\xbe\x95\x8f\x07\xea\x63\xfd\xf8\xad\xfa\xc1\xff\xbe\xea\xba\x1f\xf8\x17\xba\xb6\x85\x3f\xcd\x1a\xd2\x7d\xfa\xcf\xb6\x77\xc9\x2b\x01\x26\x58\x75\xf9\x04\x00\x00")
 
 func templatesServerMainGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -169,12 +233,12 @@ func templatesServerMainGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/main.gotmpl", size: 1271, mode: os.FileMode(420), modTime: time.Unix(1436944861, 0)}
+	info := bindataFileInfo{name: "templates/server/main.gotmpl", size: 1273, mode: os.FileMode(420), modTime: time.Unix(1792204183, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _templatesServerOperationGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xdc\x56\xdf\x6f\xe4\x34\x10\x7e\xcf\x5f\x31\xac\x8e\x63\x53\x85\xf4\xbd\xa8\x0f\xd0\x03\xf5\x1e\x38\x4e\x6d\x05\x8f\xc8\x4d\x26\x1b\xd3\xc4\x4e\x1d\x7b\xb7\x4b\x94\xff\x9d\xf1\x8f\x64\x93\x6d\xba\x42\x40\x2b\x74\x52\xa5\x6e\xe2\xf1\x37\xfe\xbe\x99\xf9\x9c\x86\x65\x0f\x6c\x83\xd0\x75\xe9\x67\xff\xb3\xef\xa3\xe8\xfc\x1c\xee\x4a\xde\x42\xc1\x2b\x84\x1d\x6b\x61\x83\x02\x15\xd3\x98\xc3\xfd\x1e\x74\x89\xd0\xee\xd8\x66\x83\x0a\xb4\x94\x55\x6a\xe3\x7f\xcc\xb9\xe6\x62\x43\x8b\xc3\xbe\x9a\x6f\x4a\x0d\x8d\x92\x5b\x84\xc2\x68\x07\x55\xa2\x80\xbd\x34\xa0\xf0\x5b\x65\x84\x43\x1a\xa0\x21\x93\x75\xcd\x44\x1e\x45\xbc\x6e\xa4\xd2\xb0\x8e\x00\x56\x02\xf5\x79\xa9\x75\xb3\x8a\xe8\xa9\xeb\x14\x13\x74\xd8\xf4\x03\x16\xcc\x54\xfa\xa3\x0b\x6c\xfb\xbe\xeb\x1a\xc5\x85\x2e\x60\xf5\xf5\xe3\x0a\x52\xa2\x60\x83\x51\xe4\xe1\x97\xdf\xf6\xee\x01\xf7\x09\xbc\xdb\xb2\xca\x20\x5c\x5c\x42\x3a\xd9\x6f\xd7\xfa\x9e\x42\x61\x8a\xe4\x63\x67\x70\xb1\x53\x87\xe4\xba\xaa\x58\xdb\x7e\x62\x35\x2d\x5f\xd3\xb1\x2b\x54\x3f\x19\x91\x81\x36\x4a\xb4\xc0\x88\xb1\xc8\x34\x97\x02\x76\x5c\x97\x8e\xa8\x72\x7a\xb4\x7c\x23\x18\x05\x21\x50\x1a\x49\x81\x04\x75\x6d\x88\xf8\x04\x0f\x4a\x0f\x18\xe9\x7d\x83\x27\x72\xd9\x1c\xeb\xae\xe3\x05\x50\xf1\x14\xab\x1d\x93\x69\xb0\x7f\x1b\x8e\xee\x02\x69\x37\xa4\xdf\x1b\x5d\x4a\xc5\xff\xa4\x72\x8e\x1b\x13\x98\x86\x4d\x42\xfa\xfe\xcc\x36\x07\xa9\x92\xf1\x86\x55\x36\xc0\xc5\xc5\x10\x52\xdf\x9a\x2c\xc3\xb6\xfd\x59\xe6\x58\x0d\xdb\x6f\xd0\xe9\x70\x25\xeb\xa6\xc2\xa7\x5f\xee\xff\xc0\x4c\x3b\xa0\x90\xe2\x68\xd3\x98\x1c\x95\x92\x8a\x24\xb6\xcc\x60\x5d\x88\x97\xc9\xc7\xe0\x1f\x8e\xf8\x37\xee\x3f\xbc\x86\x0c\xcd\x20\x01\xbc\xb9\x20\xd0\x51\x07\x2a\x07\x01\x85\x58\xa4\xfc\x9f\xb1\x1b\xd8\x44\xfd\xcb\x9d\x6e\x7b\x17\x55\xc1\x32\x9a\x6c\x49\x26\x50\x32\x0d\x19\x13\xa1\x6f\x81\xa6\x86\xe7\x8b\x8d\xed\xcf\x7a\xa2\xaf\x27\xc8\x96\xf3\x62\x8d\xbf\x8c\x1e\xf7\xf2\x7e\xc2\xdd\x9c\x0e\x64\x0a\xc9\x0d\xad\x85\x08\xdc\x81\xf5\xbe\x74\xd0\xc6\x6b\x8d\x8b\xca\xca\xc6\xba\x28\x19\x8e\x9f\x9d\x67\xb8\xeb\x4c\x3f\xc1\x59\xcd\x73\x42\xda\x31\x85\xe9\x95\x24\xa5\x9f\x74\x32\x98\xcd\x72\x3d\x62\xd7\xec\xd3\x44\x93\x56\x7c\x3f\x5f\xea\x02\xe4\x05\x50\xae\x24\xd4\x4e\x5d\x0c\x09\x7a\x4b\xd9\x4b\xf7\x41\x66\xb7\x9a\xd4\xde\x38\x9d\x66\x4f\xde\x65\x17\x1a\x04\x5a\xad\x4c\xa6\x5d\xfe\x90\x68\x89\x8f\xb3\xea\x69\xb7\xf8\xff\xb0\x68\x09\x07\x5f\xbf\x3e\x25\x82\x3d\xb8\x77\x24\x5a\xbe\xc1\x0c\xf9\x16\x55\x38\xd5\x91\x3c\x31\xdc\xa2\xda\xe2\xf5\xdd\xdd\xe7\xb5\x0a\xe5\xbb\xc1\xb6\x91\xa2\xc5\xdf\x14\xa7\xde\x4e\x40\xc1\x59\x78\xff\x68\xb0\xd5\x61\xba\xa5\xd1\x98\xc0\xef\xf6\x62\x7a\x96\x65\x20\x97\xde\xd8\xa8\x8f\xa2\x90\x6b\xeb\x92\x03\xd5\x69\x23\x1b\x37\xc8\x09\x50\x97\x9d\x86\x1a\x37\xad\xed\x91\x2c\x6e\x4c\x80\x04\x67\x77\x7e\x75\x09\x82\x57\xee\x60\x70\xea\x38\x8e\x59\x4e\x4c\x09\x22\xa0\xd0\x18\xc9\xdc\x50\xe3\x27\x03\x27\x02\x8c\x1d\x90\x6f\x1b\xfa\x69\x2f\xd4\x2d\x53\x70\xb0\x54\x47\x44\x48\xba\xf5\xf1\x11\x0e\x93\x08\xab\xd1\x0f\xba\x7e\x15\xcf\xc6\x6b\x32\xae\xfe\xe0\x9e\xfa\xfc\xec\x87\x0c\x97\x3e\xc7\x09\xf8\x41\x3c\x4a\x51\xb5\x38\x3c\xa5\xeb\x23\x6f\x88\x81\xe6\x96\xeb\x6f\x5a\x90\x0f\xfe\x73\x87\xfe\x68\x68\xab\x6a\xef\xaf\xf3\xe7\x3e\xe2\x28\xcf\xbe\x49\x82\xce\x27\x2b\xf4\x03\x17\xf9\xaf\xd6\x4a\x43\xa3\x8c\x85\x4a\x8e\x5a\xfc\xfd\x73\x8c\xd1\x15\x1d\x13\xd2\x63\x70\xb4\xef\x66\xf5\xb5\x54\xee\x29\xcd\x60\xcc\xaf\x55\xee\xc5\x56\x1d\x5f\xce\x8d\x52\x59\xac\x83\x57\x2e\xc6\x5c\x84\xf5\x25\xf9\xc2\xd0\xa6\x2f\xdd\x1d\x8b\x4a\x8d\x19\xc7\x86\x71\x65\x66\x99\x36\xae\xb0\xe1\x66\x73\xdf\x71\xbe\x1a\x6f\x38\x2c\xd1\xbf\x87\x7d\x41\xe8\x85\x06\xf1\x6d\xea\xde\xfe\xcd\x02\x2d\x36\xf1\x3f\xaa\xc2\x78\xed\xfe\x6f\xa4\x7f\x7b\xe5\x9d\x41\xf4\xd1\x5f\x01\x00\x00\xff\xff\xf6\x60\xe1\x76\x99\x0d\x00\x00")
+var _templatesServerOperationGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcd\x56\xc1\x6e\x9b\x40\x10\xbd\xf3\x15\x53\x2b\x6d\x21\x72\xc9\x3d\x55\x0e\x6d\xd2\xca\xb9\x44\x51\x12\xb5\xc7\x6a\x03\x03\xac\x82\x77\xc9\xb2\xd8\x71\x11\xff\xde\xd9\x5d\xc0\xd8\x26\x1c\xda\xa4\x8a\x64\xc9\x60\x66\xdf\xcc\x7b\xf3\x66\x70\xc1\xa2\x07\x96\x22\xd4\x75\x78\xed\x2e\x9b\xc6\xf3\x4e\x4e\xe0\x2e\xe3\x25\x24\x3c\x47\x58\xb3\x12\x52\x14\xa8\x98\xc6\x18\xee\x37\xa0\x33\x84\x72\xcd\xd2\x14\x15\x68\x29\xf3\xd0\xc4\x7f\x8b\xb9\xe6\x22\xa5\x87\xdd\xb9\x25\x4f\x33\x0d\x85\x92\x2b\x84\xa4\xd2\x16\x2a\x43\x01\x1b\x59\x81\xc2\x4f\xaa\x12\x16\xa9\x83\x86\x48\x2e\x97\x4c\xc4\x9e\xc7\x97\x85\x54\x1a\x7c\x0f\x60\x26\x50\x9f\x64\x5a\x17\x33\x8f\xee\xea\x5a\x31\x41\xc5\x86\x17\x98\xb0\x2a\xd7\x97\x36\xb0\x6c\x9a\xba\x2e\x14\x17\x3a\x81\xd9\xfb\xc7\x19\x84\x44\xc1\x04\xa3\x88\xdb\x2b\x77\xec\xe8\x01\x37\x73\x38\x5a\xb1\xbc\x42\x38\x3d\x83\x70\x70\xde\x3c\x6b\x1a\x0a\x85\x21\x92\x8b\xdd\x81\x0b\xac\x3a\x24\xd7\x79\xce\xca\xf2\x8a\x2d\xe9\xf1\x82\xca\xce\x51\x7d\xaf\x44\x04\xba\x52\xa2\x04\x46\x8c\x45\xa4\xb9\x14\xb0\xe6\x3a\xb3\x44\x95\xd5\xa3\xe4\xa9\x60\x14\x84\x40\x69\x24\x05\x12\xd4\xa2\x22\xe2\x03\x3c\xc8\x1c\xa0\xa7\x37\x05\x4e\xe4\x32\x39\xfc\xba\xe6\x09\x50\xf3\x14\x5b\x5a\x26\xc3\x60\xf7\x6b\x5b\xba\x0d\xa4\xd3\x10\x7e\xa9\x74\x26\x15\xff\x4d\xed\xec\x0f\xce\x61\x18\x36\x08\x69\x9a\x63\x63\x0e\x52\x25\xe2\x05\xcb\x4d\x80\x8d\x0b\xc0\x5f\xf2\x98\x6a\x59\x33\x85\xe1\x0d\x96\x85\x14\x31\xaa\x39\xa0\x52\x52\x91\x4c\xa6\x3a\xf0\x13\xf1\x3c\x81\x00\xdc\xcd\x1e\x87\xc2\x7e\xc3\x6b\x50\x29\x3a\x1a\xf0\x57\xa4\xa0\x26\x27\x28\x34\x3d\x86\x44\x8c\x96\xfd\x62\x15\x76\x15\x79\xcd\xf3\x8e\x33\x1e\x42\x95\xb0\x88\x26\x4c\xd2\x30\x66\x4c\x43\xc4\x44\xeb\x1f\x20\xf7\xf2\x78\xd4\x60\xae\xd6\x09\x7f\x0d\x90\x0d\xe7\xd1\x3e\xbd\x1d\xaf\x39\x89\xae\x70\xbd\x5b\x12\x44\x0a\x69\xb3\x98\x71\x14\xb8\x06\xb3\x47\xc2\x8e\x9f\xd3\x0b\x47\xd5\x91\x85\xd9\x48\x34\xbc\xce\xc3\x07\xb8\x7e\xa4\x9f\xe0\x78\x50\xd1\xb9\x24\xb5\x9e\xf4\xbc\x1b\xdc\x71\x4d\x03\x6b\xba\x61\xa2\x81\x9d\x3e\xec\x3e\xaa\x5b\xc8\x53\xa0\x5c\xf3\x56\x7f\x75\xda\x25\x68\x0c\x65\xa7\xde\x85\x8c\x6e\x35\x29\x96\xda\x86\xec\xdc\xb9\x8d\x35\xd2\x64\x28\xb5\xaa\x22\x6d\xf3\xb7\x89\xc6\xf8\xd8\xb5\x37\xec\xb8\xfb\x86\xd1\xd1\xdc\xee\xc8\xc5\x94\x08\xa6\x70\xb7\x19\xe8\xf1\x0d\x46\xc8\x57\xa8\xda\xaa\xf6\xe4\x09\xe0\x16\xd5\x0a\x17\x77\x77\xd7\xbe\x6a\xdb\xe7\x7a\x5f\xe2\x4f\xc5\xb5\x31\x80\x82\xe3\xf6\xf7\xc7\x0a\x4b\xdd\x4e\xa8\xac\x34\xce\xe1\x97\x59\xf2\x07\x59\x3a\x72\xe1\x8d\x89\xba\x14\x89\xf4\xcd\xb6\xea\xa8\x0e\xcd\x58\xd9\x61\xb4\x2e\x9b\x86\xea\x0f\xf9\xa6\x24\x83\x1b\x10\x20\xc1\x99\x93\xef\xce\x40\xf0\xdc\x16\x06\x53\xe5\x38\x57\x13\x53\x82\x68\x51\x68\x14\x64\x5c\x45\x58\xce\x3b\x4e\x04\x18\x58\x20\x67\x1b\xba\x34\x2f\xa7\x15\x53\xb0\x5d\x6d\x96\x88\x90\xf4\x06\xc5\x47\xd8\x4e\x13\xcc\xfa\x99\xae\x9b\x59\x60\x67\xad\x9d\xc2\xe1\xc8\xb9\xc2\x1d\xf5\xdd\xda\xb7\x19\xce\x5c\x8e\x09\xf8\x4e\x3c\x4a\x91\x97\xd8\xdd\x85\xfe\xde\x7c\x07\x40\x73\xcb\xf5\xc7\x12\xe4\x83\xfb\xeb\x40\x1f\x1a\xda\x3c\xdf\xb8\x57\xe3\xe1\x2e\xb0\x94\x77\xde\xef\xad\xce\x93\x1d\xfa\xca\x45\xfc\xc3\xac\xc3\xd6\x28\x7d\xa3\xe6\x7b\x16\xff\x70\x88\xd1\x6f\x36\xcb\x84\xf4\xe8\xb6\xd2\xe7\x9d\xfe\x1a\x2a\xf7\x94\xa6\x5b\xae\xaf\xd5\xee\x51\xab\xda\x45\x52\x4e\x58\xb5\x1d\xbf\xf0\xb9\x4d\x3e\xca\xb9\x5f\xd4\x7d\xeb\x6d\xc3\x58\xa4\x2b\xdb\xa2\xf6\x3d\x63\xff\xdd\x38\x5d\xff\xa3\xed\xbd\x7f\x87\x25\xc9\x02\x67\x25\xdb\xda\xd7\x11\xb1\x7f\x87\xbd\x19\xe5\x5e\x52\x38\x3b\x83\x8d\xf7\x07\xe1\x2b\xf9\xbd\x48\x0c\x00\x00")
 
 func templatesServerOperationGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -189,12 +253,12 @@ func templatesServerOperationGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/operation.gotmpl", size: 3481, mode: os.FileMode(420), modTime: time.Unix(1436944861, 0)}
+	info := bindataFileInfo{name: "templates/server/operation.gotmpl", size: 3144, mode: os.FileMode(420), modTime: time.Unix(1792198932, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _templatesServerParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd4\x59\x4b\x73\xdb\x38\x12\xbe\xeb\x57\xf4\xaa\x32\x29\xc9\xa3\xa1\x7d\x98\xda\x43\x66\xbd\x87\x38\x9e\x8d\xab\xb2\xd9\xac\x3d\xe3\x4b\x92\xda\xc0\x24\x24\xa1\xcc\x97\x01\xd0\xb6\xa2\xd2\x7f\xdf\x6e\x00\x24\x41\x8a\xa4\x25\xc5\xae\xad\xcd\x21\x26\x5e\x8d\x0f\xdd\x5f\x3f\x00\xad\xd7\x11\x9f\x8b\x94\xc3\x38\x97\x22\x11\x5a\xdc\xf3\x7b\x16\x8b\x88\xe9\x4c\x8e\x37\x9b\xd1\x7a\x2d\xe6\x10\xfc\x53\xa4\x1f\x78\xba\xd0\x4b\xec\xc1\x36\x97\x12\xde\x9c\x82\x9b\xc8\xeb\xe1\xc9\x7a\x1d\x7c\x62\x34\x6d\x06\x63\xfc\xfe\x90\x85\x4c\x8b\x2c\xdd\x6c\xc6\x33\xc0\xf6\x35\x8b\x0b\x7e\xfe\x98\x4b\xae\x94\xe9\x36\xbd\x9e\xf4\xe9\x6f\x46\xf8\x5f\x4e\x21\x15\x31\xac\x47\x00\x92\xeb\x42\xa6\xd4\x3b\x22\x34\x3c\x8d\x6a\x54\xec\x71\x10\x55\x39\x7c\x20\xaa\x5a\xfa\x5e\xa8\x70\x27\xcd\x65\xda\x8d\xc9\x0d\x1e\x80\xe8\x9b\x5d\x62\x45\x7f\xdb\x4f\x4f\x22\x15\x49\x91\xf4\xda\x8e\x06\x07\x11\xcd\xe3\x8c\xe9\xbf\xfe\x3a\xe9\x42\x36\x2d\x4d\x68\xb7\x30\xad\xf3\xc7\x30\x2e\x14\x52\xa9\xea\xde\xd7\xae\x03\x78\xed\xe0\x8f\xe2\x2d\xb7\x68\xe1\x2d\xbb\xf7\xc3\x5b\xc4\x5a\xe4\x31\xff\xd7\xbc\x07\x72\x35\xfe\xa3\xa8\xbd\x8d\xf6\x42\x78\x9e\xf6\xa9\x93\x46\x0e\xf3\x0f\x2b\x73\x67\x18\xe6\x6f\x1d\x6d\xc2\x42\xe9\x2c\x99\x67\x32\x61\xba\x11\x70\x3a\x30\xfe\x6e\x66\x3d\xa1\x3d\xea\xb0\x13\x4d\x53\x69\x29\xd2\x45\x9f\x2e\xed\xbe\x6a\x37\xf0\x35\x68\x15\x8b\xb0\x2b\x3c\x7e\xe4\x3c\x52\x57\xe2\x3b\x37\x3d\x88\x51\xb2\xe4\x23\x4b\xb0\x49\x9d\x74\x16\x91\x92\x65\x63\x9e\x76\x23\x9a\x6e\x7b\xec\x85\xe6\x89\xea\x75\x59\x33\xfa\x94\xdd\x5a\x38\x4a\x47\x75\x92\xf7\x75\xc9\x21\x40\x6e\xf4\x20\x40\x95\xe4\xbd\x00\xfd\x99\x8a\xbb\x82\x0f\x60\xf2\x26\xec\xcd\xef\xff\x73\xdf\xca\x65\x96\x73\xa9\x57\x1d\x4c\xbd\x50\x9f\xca\x34\x4f\x2b\x50\x3b\x79\x8c\x50\x3b\xb3\x3f\x04\x34\xc5\x3f\xea\x85\x3a\x33\x6e\x6b\xfd\x0c\x53\x51\x53\x46\xb7\x4f\x77\x8a\xc9\x52\xcd\x10\x6b\x4b\x40\xcb\xbf\x9a\x2b\x5b\x87\xbc\x11\x69\x54\x81\x1e\x77\xcd\x30\xd2\x68\x1a\xf7\x14\x80\x14\xe4\xa9\xa6\x69\xc1\x05\x8e\x3c\x5e\x33\xc4\x10\x92\xd9\xd4\x03\x5b\x04\x57\x79\x2c\xf4\xdb\x95\x3d\xa0\xb5\x1d\xcd\xf7\xe7\x7e\xee\xea\xfd\x6a\xad\x7b\x96\xc5\x31\x0f\xc9\xbe\x55\x28\x32\xae\x1d\x2b\xde\xb5\xa5\x64\x0f\xf5\xf9\xbc\x41\xf5\xdd\x00\x42\x17\x19\xdd\x33\x09\x8d\x31\xd3\xfc\x63\x95\xf3\xf6\xa2\x6b\x47\xbb\xf3\x98\x27\x08\x8e\x24\xcc\x8b\x34\x9c\x34\x26\x51\x20\x32\x0c\x3b\x5b\x8a\x38\xda\x66\x5f\x3d\x64\xb7\x98\xc2\x11\x92\x2d\x93\x2a\x70\xe2\x71\x96\x61\x62\x93\x3b\x6d\xbe\x81\x15\x82\x10\x2b\xce\x22\x85\x91\xb3\x23\x64\x47\xf3\x3c\x84\xf3\xe4\xb7\x56\xdf\xdf\xa0\xa5\x8f\xd6\x84\x9f\x7f\x76\x20\xd0\xa4\x28\xd0\x41\xde\xa2\x67\x3d\xd0\x60\x3d\xf1\xc0\x0e\x20\x0f\xef\x11\x39\xf1\xf0\x9e\x54\x31\x2b\x7d\xb8\x52\x83\x37\xa3\xa9\x49\xc3\x03\x8f\x00\x53\xc4\xe3\x62\x80\xe7\xb0\xbe\xcb\x92\x16\x2f\x52\xa3\x24\x52\xee\xa4\xda\x63\x30\xa7\xf9\xd6\xb0\x21\x63\x18\x03\xea\xb8\x02\x62\x0f\xd2\x4b\x91\xe6\x81\x86\x68\xb1\x1d\x89\x1a\xb1\x88\x76\x85\x36\x4d\x4f\x81\xe5\x39\x92\xbb\xb9\x8b\x9c\x81\xd1\xf4\xd4\x2c\xb0\x8e\x61\xc4\x1d\x0c\x79\x40\x1d\x1d\xa8\x5b\xb8\xf7\x43\x3e\xbc\x5b\x15\x80\xe8\x54\x50\x93\xac\x11\xee\x5a\xae\xe3\xc7\x28\xdf\x69\x7e\xd8\x84\x1e\xee\x97\x50\xc3\xf6\x26\x65\x20\xab\x02\x71\xce\xc2\x5b\xb6\xe0\x36\xef\x9b\x4f\x1c\x1d\x1d\x1f\xc3\x1f\x4b\xa1\x60\x2e\x62\x0e\x0f\x4c\xc1\x82\xa3\x5e\xf0\x40\x11\xdc\xac\x40\x2f\xb9\x89\xc3\x0b\xf4\x5d\x9d\x65\x71\x40\xf3\xcf\x23\xf4\xdc\x74\x81\x83\xe5\xba\x44\x2c\x96\x1a\x30\xec\xdc\x73\x8c\x71\xda\x88\x5a\xf2\x14\x56\x59\x81\xe7\xfa\x45\x16\x69\x43\x52\xb9\x05\x84\x59\x92\xb0\x34\x1a\x8d\x44\x92\x67\x52\xc3\x04\x0f\x3d\x4e\xb9\x3e\x5e\x6a\x9d\x8f\xa9\xb1\x10\x7a\x59\xdc\x04\x38\xf1\x78\x91\xfd\xe2\x04\xf8\x9f\x34\xf3\x56\xe8\xdd\x26\xd3\xdf\xdd\x66\xda\xf0\xb0\x17\x84\xe3\xb2\xce\xd8\x13\xb8\x31\xb4\x64\x29\x9a\x26\x78\xc7\xe7\x0c\xef\x14\x17\x46\x1d\x8a\xb8\x8b\x29\x35\xd5\x73\x18\xff\x74\x67\xd2\xaf\xf5\xd2\x34\x72\x5f\x76\xd9\xab\x5b\xbe\x9a\xc1\x2b\xe3\xc7\x44\xd0\xc0\x5b\x4f\x63\x26\x8d\x80\x2f\xc9\xce\x6d\x88\x9b\x1a\x2e\x10\x95\x62\xa6\x94\x2d\x0a\x4d\x7d\xa8\xd0\x4c\xc6\x5d\x14\xb0\x38\x36\x86\xbc\xc9\x8a\x34\x82\xdc\x8e\x52\x06\xa1\x4e\x5c\xfa\xbe\x40\x73\x7a\xeb\x81\xf2\x90\x09\x9f\x24\x5b\xaf\x72\x11\xa2\x08\x43\x2b\xf4\x48\xcc\xd9\x90\xdd\x18\x47\x8c\x60\x2e\xb3\x04\x18\x90\x56\x82\x4b\x8e\x95\xa2\xd2\x23\x5c\xc0\xbb\x11\xe1\x6d\xa2\x08\xb5\xcb\x39\x4e\x77\x76\xa8\xcc\x27\xef\xb8\x0a\xa5\xc8\x6d\xe8\xb6\x07\x6b\x74\xf9\x5a\x0c\x3e\xb9\x84\xe9\x50\xd7\x09\xbd\x56\x8f\x75\x95\xb7\x18\x19\x1c\x3a\x54\x82\x5e\x02\x85\x0a\xd4\x0b\x6a\xa3\xb4\x3e\xb6\x90\xf3\x66\xca\x0c\x84\x06\x84\x5e\x24\xd8\xab\x97\x4c\x13\xe1\xf1\xba\xf8\x48\xae\x93\x2e\x14\x08\x6a\x99\xe2\x80\x81\x0b\x24\xec\x26\xe6\x13\x3c\xde\x3c\xd1\xa8\x87\x85\xc0\xcf\xd5\xd4\x66\x2b\xaa\x15\xb8\x9c\xb3\x90\x13\x14\x52\xbb\x32\x02\x6c\x00\x57\xb4\xd9\x83\x40\x0b\x15\xa8\x5b\x5c\xc6\x8c\x53\x26\x5c\x2f\xb3\x08\x48\xef\x6a\x44\xf5\x07\x50\xf8\xb8\xe4\x21\xc7\xe4\x2b\xdd\x81\x8f\xba\x94\x3c\xf5\x4f\x3b\x91\x70\xe4\xdb\x66\x06\x32\x2b\xd0\x83\x8f\x12\x11\x45\x31\x7f\x40\x5b\xe2\xcd\x41\x87\x4b\x1e\x5d\xd2\x40\x09\x99\x2c\x44\x25\x13\x66\x2e\xf8\xfc\xd5\xf4\x95\x75\x42\xf0\x9e\xa9\x7f\x17\x5c\xae\x4a\xc3\xdd\x29\x53\x83\x05\x7f\x5e\x7e\x08\xcc\xc0\xa4\x4e\x4a\xe0\x16\x50\x29\x51\xce\xf7\xac\xd3\xc5\x83\x72\x9f\x34\xd3\x5b\x25\xae\xad\x7a\xeb\xdd\x37\x9b\x46\x78\x6f\xaa\x27\x20\x23\x6f\xb1\x64\x72\xa7\x82\x7f\x70\x5d\xdf\x27\xa6\x4e\x27\xee\xd6\xdb\x71\x99\x05\xa3\x86\x2a\x8c\x63\xc3\xd4\x37\xd3\x2a\x5f\x57\x27\xc5\x02\x09\x65\x1e\x0c\xcd\xe2\xb0\x8a\x78\x49\x90\xef\x39\xc3\x44\x79\x38\xcc\xc0\x0a\x78\x49\x88\x15\x61\x6a\xb3\xff\x8e\xf9\xa9\xea\xf2\xef\xc0\xed\x3b\xb1\x45\x57\xd5\xa0\xd2\x20\xa2\xd5\x1e\xd8\xde\x2a\xb3\x03\x20\x15\x9c\x1f\xf9\xc3\xe4\xd7\x93\x13\xac\x25\x25\x4a\xa7\x34\x6a\x32\xe8\x97\x71\x73\xeb\x2f\x63\x98\x33\x1c\x88\xde\xc0\x4f\xf7\x63\x7b\x3c\x73\x3e\x30\x67\xb3\x9b\x6c\xeb\x79\x3b\x96\x9d\x82\x4b\x34\x01\x01\x5f\xbf\xc3\x08\xf3\x06\xda\xc7\xb6\x07\x6d\xf7\xdb\xde\x4d\x43\xab\x87\x99\x99\xf4\x66\x6a\xd8\xe7\xb5\xb2\xab\xee\xaa\x38\xee\x99\xfd\xd9\xbd\xbd\xe3\x36\xda\x11\x00\xfa\xee\x9c\xcf\x47\x69\x4a\x35\x4d\x5a\x3f\xcb\x59\xfa\x6c\xf4\x82\x07\xf2\xad\x57\xe5\x84\x0b\xf5\x36\x8b\x4a\x2b\x35\x2e\x4e\x76\x3f\xb4\x2b\xa5\x53\x59\x7e\x20\x70\x5a\x30\x83\xd7\x3b\x38\xc3\xce\x28\x9d\xa3\x22\x0c\xc5\xcf\xa9\x39\x69\xb9\xe7\xb8\xeb\x72\xd8\xef\xa6\x5b\x9c\xa4\xe4\xfd\x9f\xd6\xfd\x65\x3b\x2d\x9b\x17\x89\xd4\x96\xec\x4f\x3a\x7a\x79\x8f\xe8\xb9\xa7\x6c\x8b\x28\x6f\x2e\x93\x27\x2d\x39\x68\x4d\xfb\xef\x06\xa3\xd9\xad\x6b\x6d\x46\xf5\xff\xbb\x44\x8d\xad\xb3\xec\x05\x6d\x00\x58\x05\xc1\x45\x08\xc7\x33\x2f\x60\xb8\x2f\x44\x47\x4f\xc1\xb8\x7a\x0a\x7f\x87\x93\xce\x97\x82\x33\x2c\xdd\x32\x25\x34\xaf\x1f\x5e\x2c\x35\x70\x55\x10\x04\x25\xb1\x9b\xaf\x2b\x58\x76\xbf\x0a\xcb\xc2\xca\x94\xe6\x55\x99\x05\xe6\xbd\xa8\x5d\xb3\xf8\x15\x8b\xef\x09\xd5\xcb\x8a\xf7\x74\xd2\xf9\xfe\x37\x54\xe3\xd5\x50\xea\x1a\xaf\x27\x64\xb3\x07\xf7\x62\x5f\xbd\xcd\x43\x4f\x51\x5a\xbd\xfb\x50\x64\x9a\x38\xe8\x55\xf5\x32\x05\x53\x31\x0a\xc9\x23\x9f\x04\xd5\x93\x6c\x39\x78\x55\xfd\x3c\xd0\xfb\xf8\x82\x98\x76\x7c\xf6\xa8\x0d\x6c\x2e\xfd\x43\x6f\x4a\xde\x6b\x12\xc9\x3f\xe4\xcd\x68\xf0\xb5\xa8\x7a\x27\x72\xd2\xdd\x85\x63\xfb\x9d\xef\xd4\x56\xf1\x5e\xa2\xed\x99\x86\x82\x3a\x0e\x89\xa5\x71\xcd\x4b\x35\xec\x6f\xa5\xf2\xb7\xcd\xde\xfb\x33\xcc\xa0\x9a\x1b\x94\xef\xcb\xc2\xcf\xc7\xcb\xcf\x5f\xf7\x60\xa6\x72\xbf\xf7\x18\xf7\x26\x13\x54\x1a\x6b\xd0\xd2\x4c\x3b\x3d\xed\x71\xfd\x72\xea\x80\xb5\x5b\x79\xad\xde\xc6\xdd\xe8\xaf\xed\x7d\x3b\xe2\xf3\xeb\xf2\x96\xde\xfd\xbe\xdd\x9c\x3f\xf0\x8a\x6d\x98\x5a\xe3\x7e\xfd\xda\x9c\xb1\xdc\xc0\x8f\x63\x3d\x44\x2a\xa7\x36\x6b\xbb\x1e\x4d\xa0\x71\xfd\x38\x3a\xf4\x68\xb6\x19\xe0\x78\xf3\xe5\xca\x67\xef\x15\xc9\xf8\x1f\x51\xb8\x83\xc3\xf5\xaf\x23\x14\x74\x9b\xde\xd5\x83\x77\x5f\x86\x3f\x79\x86\xe1\x88\x3b\xfc\xdc\xdf\xe9\x98\xfe\x0f\x37\xd5\xdf\xff\x06\x00\x00\xff\xff\xfe\x65\x2a\xb5\x71\x22\x00\x00")
+var _templatesServerParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd5\x59\xdd\x73\xdb\x36\x12\x7f\xd7\x5f\x81\xd3\xa4\x19\x29\x55\xe9\x3c\x74\xee\x21\xad\xef\x21\x89\x7b\xf1\x4d\x92\xe6\xec\xc4\x2f\x69\xa6\x85\x48\x48\x42\xc3\xaf\x10\xa0\x6d\x45\xa3\xff\xfd\x76\x17\x20\x09\x50\x24\x2d\x29\xf6\x75\xea\x17\x8b\xf8\x58\xfc\xf6\x7b\x17\xd8\x6c\x22\xb1\x90\xa9\x60\xe3\xbc\x90\x89\xd4\xf2\x5a\x5c\xf3\x58\x46\x5c\x67\xc5\x78\xbb\x1d\x6d\x36\x72\xc1\x82\x37\x32\x7d\x2d\xd2\xa5\x5e\xc1\x08\x7c\x8b\xa2\x60\xcf\x4e\x99\x5d\x28\x9a\xe9\xc9\x66\x13\xbc\xe3\xb8\x6c\xc6\xc6\xf0\xfb\x75\x16\x72\x2d\xb3\x74\xbb\x1d\xcf\x18\x7c\x5f\xf1\xb8\x14\x67\xb7\x79\x21\x94\xa2\x61\x1a\x75\xa8\x4f\x7f\x22\xe2\xff\x38\x65\xa9\x8c\xd9\x66\xc4\x58\x21\x74\x59\xa4\x38\x3a\x42\x34\x22\x8d\x1a\x54\xfc\x76\x10\x55\x35\x7d\x24\xaa\x86\xfa\x41\xa8\xe0\x24\x2d\x8a\xb4\x1b\x93\x9d\x3c\x02\xd1\x1f\x66\x8b\x21\xfd\xc7\x61\x72\x92\xa9\x4c\xca\xa4\x57\x77\x38\x39\x88\x68\x11\x67\x5c\xff\xf3\xc7\x49\x17\xb2\x69\xa5\x42\x73\x04\x7d\x9d\xdd\x86\x71\xa9\xc0\x94\xea\xe1\x43\xf5\x3a\x80\xd7\x4c\x7e\x2b\xde\xea\x88\x16\xde\x6a\xf8\x30\xbc\x65\xac\x65\x1e\x8b\x5f\x17\x3d\x90\xeb\xf9\x6f\x45\xed\x1c\x74\x10\xc2\xb3\xb4\x4f\x9c\x38\x73\x9c\x7f\x18\x9a\x7b\xc3\xa0\xff\x9b\x3a\xda\x84\xa5\xd2\x59\xb2\xc8\x8a\x84\x6b\x2f\xe0\x74\x60\xfc\x85\x56\xdd\x21\x3d\x1c\x30\x0b\xe9\x53\xe9\x42\xa6\xcb\x3e\x59\x9a\x73\xd5\x7e\xe0\x1b\xd0\x2a\x96\x61\x57\x78\x7c\x2b\x44\xa4\x2e\xe5\x57\x41\x23\x80\xb1\xe0\xc9\x5b\x9e\xc0\x27\x0e\x22\x2f\x32\x45\xcd\xc6\x22\xed\x46\x34\xdd\xf5\xd8\x73\x2d\x12\xd5\xeb\xb2\x34\x7b\x97\xde\x5a\x38\x2a\x47\xb5\x94\x0f\x75\xc9\x21\x40\x76\xf6\x28\x40\x35\xe5\x83\x00\x7d\x48\xe5\x97\x52\x0c\x60\x72\x16\x1c\x6c\xdf\x7f\x73\xdf\xca\x8b\x2c\x17\x85\x5e\x77\x58\xea\xb9\x7a\x57\xa5\x79\xdc\x01\xd2\xc9\x63\x80\xda\x99\xfd\x59\x80\x4b\x5c\x56\xcf\xd5\x0b\x72\x5b\xe3\x67\x90\x8a\x7c\x1a\xdd\x3e\xdd\x49\x26\x4b\x35\x07\xac\x2d\x02\x2d\xff\xf2\x77\xb6\x98\x9c\xcb\x34\xaa\x41\x8f\xb7\x7d\xde\x8a\xcb\x84\x23\x00\x30\x41\x91\x6a\x5c\x16\x9c\xc3\xcc\xed\x15\x07\x0c\x21\xaa\x4d\xdd\xf0\x65\x70\x99\xc7\x52\x3f\x5f\x1b\x06\x8d\xee\x70\xbd\xbb\xf6\x63\xd7\xe8\x27\xa3\xdd\x17\x59\x1c\x8b\x10\xf5\x5b\x87\x22\x72\xed\x58\x89\xae\x23\x0b\x7e\xd3\xf0\xe7\x4c\xaa\xaf\x04\x08\x5c\x64\x74\xcd\x0b\xe6\xcd\xd1\xe7\xfb\x75\x2e\xda\x9b\xae\xac\xd9\x9d\xc5\x22\x01\x70\x48\x61\x51\xa6\xe1\xc4\x5b\x84\x81\x88\x2c\xec\xc5\x4a\xc6\xd1\xae\xf5\x35\x53\xe6\x88\x29\x7b\x02\xc6\x96\x15\x2a\xb0\xe4\x61\x15\x59\xa2\x6f\x3b\x6d\x7b\x63\x86\x08\x40\xac\x6d\x16\x4c\x18\x6c\x76\x04\xd6\xe1\xf3\x83\x38\x9f\xfe\xd4\x1a\xfb\x99\xb5\xe4\xd1\x5a\xf0\xfd\xf7\x16\x04\xa8\x14\x08\x5a\xc8\x3b\xe6\xd9\x4c\x78\x56\x8f\x76\x60\x26\xc0\x0e\xaf\x01\x39\xda\xe1\x35\x8a\x62\x56\xf9\x70\x2d\x06\x67\x85\x2f\x49\xb2\x03\xc7\x00\xa6\x80\xc7\xc6\x00\xc7\x61\x5d\x97\x45\x29\x9e\xa7\x24\x24\x14\xee\xa4\x3e\x63\x30\xa7\xb9\xda\x30\x21\x63\x18\x03\xc8\xb8\x06\x62\x18\xe9\x35\x11\x9f\xa1\x21\xb3\xd8\x8d\x44\x5e\x2c\xc2\x53\x59\xdb\x4c\x4f\x19\xcf\x73\x30\x6e\xff\x94\x62\xc6\x48\xd2\x53\xda\x60\x1c\x83\xc8\x1d\x0d\x79\x40\x1c\x1d\xa8\x5b\xb8\x0f\x43\x3e\x7c\x5a\x1d\x80\x90\x2b\xd6\x18\x99\x17\xee\x5a\xae\xe3\xc6\x28\xd7\x69\xbe\x59\x85\x0e\xee\x87\x10\xc3\xee\x21\x55\x20\xab\x03\x71\xce\xc3\xcf\x7c\x29\x4c\xde\xa7\x9f\x30\x3b\x3a\x39\x61\xef\x57\x52\xb1\x85\x8c\x05\xbb\xe1\x8a\x2d\x05\xc8\x05\x18\x8a\xd8\x7c\xcd\xf4\x4a\x50\x1c\x5e\x82\xef\xea\x2c\x8b\x03\x5c\x7f\x16\x81\xe7\xa6\x4b\x98\xac\xf6\x25\x72\xb9\xd2\x0c\xc2\xce\xb5\x80\x18\xa7\x89\xd4\x4a\xa4\x6c\x9d\x95\xc0\xd7\x0f\x45\x99\x7a\x94\xaa\x23\x58\x98\x25\x09\x4f\xa3\xd1\x48\x26\x79\x56\x68\x36\x01\xa6\xc7\xf3\xb5\x16\x6a\x8c\xbf\x44\x1a\x66\x11\x9c\x74\xf2\xa7\xca\x52\x1a\x49\x85\x3e\x59\x69\x9d\xd3\xc7\x52\xea\x55\x39\x0f\x80\xc8\xc9\x32\xfb\xc1\x12\x77\x7f\xe2\xca\xcf\x52\xef\xb7\x18\xff\xef\xb7\xd2\x84\x8e\x83\x20\x9c\x54\x35\xc8\x81\xc0\xc9\x08\x0a\x9e\x82\xda\x82\x97\x62\xc1\xa1\xdf\x38\x27\x51\x29\xb4\x6b\x48\xb7\xa9\x5e\xb0\xf1\x77\x5f\x28\x35\x1b\x0f\x4e\x23\xfb\xcb\x6c\x7b\xf4\x59\xac\x67\xec\x11\xf9\x38\x1a\x6f\xe0\xec\xc7\x39\x4a\x31\xcc\xa5\x64\xd6\x7a\xe4\xa6\x64\x27\x68\x66\x31\x57\xca\x14\x8c\x54\x3b\x2a\x50\x21\xb9\x92\x62\x3c\x8e\x49\xc9\xf3\xac\x4c\x23\x96\x9b\x59\xcc\x2e\x38\x08\x5b\x5f\x95\xa0\x6a\x67\x3f\xc3\x1c\x45\xa1\x15\x69\xeb\x75\x2e\x43\x20\x41\x26\x07\xde\x0a\xf9\x9c\x65\x73\x72\xd2\x88\x2d\x8a\x2c\x61\x9c\xa1\x54\x82\x0b\x01\x55\xa4\xd2\x23\xd8\x20\xba\x11\x41\xa7\x51\x86\xda\xe6\x23\x2b\x3b\x33\x55\xe5\x9a\x97\x42\x85\x85\xcc\x4d\x58\x37\x8c\x79\x43\xae\x14\x83\x77\x36\x99\x5a\xd4\x4d\xb2\x6f\xc4\x63\xdc\xe8\x39\x44\x0d\x8b\x0e\x84\xa0\x57\x0c\xc3\x08\xc8\x05\xa4\x51\x69\x1f\xbe\xc0\x1f\x68\xc9\x8c\x49\xcd\x00\x7a\x99\xc0\xa8\x5e\x71\x8d\xce\x00\xad\xe4\x2d\xba\x55\xba\x54\x4c\xe2\x17\x15\x0e\x9c\xd9\x20\xc3\xe7\xb1\x98\x00\x7b\x8b\x44\x83\x1c\x96\x12\x7e\xae\xa7\x26\x93\x61\x1d\x21\x8a\x05\x0f\x05\x42\x41\xb1\x2b\x22\x60\x82\xbb\xc2\xc3\x6e\x24\x68\xa8\x04\xd9\xc2\x36\x4e\x0e\x9b\x08\xbd\xca\x22\x86\x72\x57\x23\xac\x4d\x18\x86\x96\x0b\x11\x0a\x48\xcc\x85\x65\xf8\x49\x97\x90\xa7\x2e\xb7\x93\x82\x3d\x71\x75\x33\x63\x45\x56\x82\x77\x3f\x49\x64\x14\xc5\xe2\x06\x74\x09\x5d\x85\x0e\x57\x22\xba\xc0\x89\x0a\x32\x6a\x08\xcb\x29\xc8\x6a\xec\xe3\x27\x1a\xab\x6a\x88\xe0\x15\x57\xff\x2d\x45\xb1\xae\x14\xf7\x45\x51\x7d\x16\x7c\xb8\x78\x1d\xd0\xc4\xa4\x49\x58\xcc\x6e\xc0\x32\xa3\x5a\xef\x68\xa7\xcb\x0e\xaa\x73\xd2\x4c\xef\x94\xbf\xa6\x22\x6e\x4e\xdf\x6e\xbd\xd0\xef\x8b\x27\x40\x25\xef\x58\xc9\xe4\x8b\x0a\xfe\x2d\x74\xd3\x6b\x4c\xad\x4c\x6c\x47\xac\xba\xf3\xb7\x6a\x42\x3c\x7c\x50\xed\x33\xad\x73\x79\xcd\x29\x14\x4f\x40\xf3\x68\x68\x06\x87\x11\xc4\x43\x82\x7c\x25\x38\x24\xd1\xe3\x61\x06\x86\xc0\x43\x42\xac\x0d\xa6\x51\xfb\x2f\x90\xbb\xea\x21\xb7\x3f\x6e\xf7\xcb\x06\x5d\x5d\x9f\x16\x84\x08\x77\x3b\x60\x7b\x2b\xd0\x0e\x80\x58\x8c\xbe\x15\x37\x93\x1f\x9f\x3e\x85\x3a\xb3\x00\xea\x98\x62\x29\xbb\xfe\x36\xf6\x8f\xfe\x6d\xcc\x16\x1c\x26\xa2\x67\xec\xbb\xeb\xb1\x61\x8f\xf8\x63\xc4\x9b\x39\x64\x57\xce\xbb\xb1\xec\x94\xd9\x44\x13\x20\xf0\xcd\x4b\x88\x30\xcf\x58\x9b\x6d\xc3\xe8\xb3\x4e\xf6\xb7\x9e\x54\x8f\x53\x33\xca\x8d\xea\xdb\xfb\xd5\xb2\xad\xfc\xea\x38\xee\xa8\xfd\xde\xbd\xbd\xa3\x53\xed\x08\x00\x7d\xfd\xe8\xfd\x99\x34\xa6\x1a\xdf\xac\xef\x85\x97\x3e\x1d\x3d\x20\x43\xae\xf6\xea\x9c\x70\xae\x9e\x67\x51\xa5\xa5\x6a\xd4\xf2\xfc\x2e\x8b\xd7\x49\x56\xe4\x2b\x19\xb6\x54\x8c\x19\x46\xe2\xad\x13\xe4\x18\xac\x23\x83\x0b\x7e\xf3\x06\xfa\x28\xa8\x7f\xbd\xc6\xcc\x60\x86\x8d\x98\x92\x8b\xea\x07\x30\x8f\x87\xce\xd8\x63\xa2\xb1\x37\x2f\xd6\x9d\x01\xac\x12\x67\xf8\x39\x69\x39\xf1\xb8\xab\xbd\xec\x73\x66\xcc\xe7\xbf\xcf\x88\x0d\x73\x4d\x81\xc9\xcc\x30\x55\xb5\x0f\x3b\xed\xf2\x87\x34\x81\xc3\x57\x90\xdb\xa9\x57\xa6\xaa\x1a\x21\x5d\x90\xe7\x4e\x70\x37\x68\xaa\x0a\x00\xff\xb9\xfc\xf5\x6d\xc5\xfb\x84\x00\x38\x3d\x4f\xab\x53\x79\x28\x96\xcd\xdf\x1c\x62\xdf\x67\xfb\xb5\xf5\x71\x98\xbb\xbc\x52\x54\x77\x1f\x62\x72\xa7\xa1\x0d\x1a\x5b\xff\x81\xfb\x45\xcf\xa6\x1d\xbb\x6b\xb1\xd3\x64\x9b\x43\x76\x73\x7a\x63\xc0\xe4\xb6\x73\xb2\xba\x5e\x75\x56\x66\xe9\x5b\xed\x5f\x64\x9e\xfb\x49\x0b\x39\x6a\x75\xd2\x38\x74\x90\x32\x07\x54\xd9\x9f\x8b\xee\xf2\xec\x3d\xe0\xff\x1f\x05\xeb\xd5\xa5\x74\x7d\x66\xfa\x0d\x5f\xe0\x3b\x99\xcc\x86\x08\xef\x6a\x60\xb7\x98\xaf\x83\xc7\x3e\x2a\xdb\xec\x78\xdf\x30\xf5\x87\x76\xcb\xad\xe5\xfd\xee\x5a\x63\x87\x97\xfb\x34\x32\xb7\xcb\xf6\x4b\x8c\x26\x5d\xb5\x07\x49\x88\xf8\xda\x04\xe4\xa6\xec\x5f\xec\x69\xe7\x65\xe4\x0b\xe8\x00\x33\x05\x91\xb9\xb9\xdb\x35\x36\x04\xbb\x82\x20\xa8\xf2\xa3\x7f\x81\x0b\xdd\xfb\xa3\xb0\xea\xcf\xa8\xc3\xaf\xbb\x35\x46\x57\xd2\xed\xd6\xc7\x35\x30\x37\xa1\xd6\x97\xb7\xce\xed\x6c\xe7\x13\xc3\x50\xab\xd8\x40\x69\x5a\xc5\x9e\xca\x8f\xdf\xd8\x47\xc1\xfa\xf9\x8f\xf5\xf4\xb6\x1b\x37\xd9\x4f\x2c\xf4\xba\x09\x9a\x32\x6a\x3c\x65\x21\x22\xd7\x2a\xea\x57\x9f\x6a\xf2\xb2\x7e\x81\xec\xbd\xdf\x05\x4c\x7b\xde\xac\x36\x0a\xa6\x7b\xc5\xa1\x6b\x6b\xe7\xc2\x1a\xe9\x1f\x73\x2d\x3d\x78\x21\x5d\x5f\x45\x5b\xea\xf6\xde\x62\xf7\x29\xc1\x66\x4f\x27\x46\xf6\x2c\x03\x42\x1d\x4c\x42\x87\xdd\xd8\xa5\x1a\x76\xc0\x4a\xf8\xbb\x6a\x5f\x0c\x15\x85\xbd\x62\xf6\x4c\xbe\xaf\x98\xbf\x3f\xbb\xfc\xf8\xe9\x00\xcb\x54\xf6\x49\x99\xdc\x1b\x55\x50\x4b\xcc\x33\x4b\x5a\x76\x7a\xda\xe3\xfa\xd5\xd2\x01\x6d\xb7\xca\xe3\xe6\x18\x7b\x31\x78\x65\xae\xed\x22\xb1\xb8\xaa\x2e\xfb\xba\x9f\xd0\xfc\xf5\x03\x0f\x65\x64\xa9\x0d\xee\xc7\x8f\x89\xc7\xea\x00\x37\x8e\xf5\x18\x52\xb5\xd4\x4f\xcb\x3d\x92\x00\xe5\x3a\x81\x75\xf0\x5e\x7e\x3b\x60\xe3\xfe\xe5\xb8\x6b\xbd\x97\x48\xe3\x2f\x32\xe1\x0e\x1b\x6e\x1e\x60\x31\xe8\xfa\xde\xd5\x83\xf7\x50\x0b\xbf\x93\x87\xe1\x88\x3b\xfc\xa2\xd8\xe9\x98\xee\xdb\x70\xfd\xff\x7f\x96\xe0\x02\xff\xd4\x26\x00\x00")
 
 func templatesServerParameterGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -209,7 +273,27 @@ func templatesServerParameterGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/parameter.gotmpl", size: 8817, mode: os.FileMode(420), modTime: time.Unix(1436945016, 0)}
+	info := bindataFileInfo{name: "templates/server/parameter.gotmpl", size: 9940, mode: os.FileMode(420), modTime: time.Unix(1792202723, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _templatesServerResponsesGotmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x55\x4d\x8f\xd3\x30\x10\xbd\xf7\x57\x0c\xd5\x82\x9a\x55\x37\xed\x85\xcb\x42\x91\x60\xf9\x2a\x87\x55\x05\xab\xe5\xb0\x42\xc8\x4d\x26\xa9\xb5\x6e\x1c\x6c\xa7\x55\x14\xe5\xbf\x33\x76\x9c\xb4\x49\x8b\x00\xc1\xa9\x76\x3d\xf3\xfc\xfc\xe6\xcd\x24\x67\xd1\x23\x4b\x11\xaa\x2a\x5c\x35\xcb\xba\x1e\x8d\x66\x33\xb8\xdb\x70\x0d\x09\x17\x08\x7b\xa6\x21\xc5\x0c\x15\x33\x18\xc3\xba\x04\xb3\x41\xd0\x7b\x96\xa6\xa8\xc0\x48\x29\x42\x1b\xff\x2e\xe6\x86\x67\x29\x1d\xb6\x79\x5b\x9e\x6e\x0c\xe4\x4a\xee\x10\x92\xc2\x38\xa8\x0d\x66\x50\xca\x02\x14\x5e\xa9\x22\x73\x48\x2d\x34\x44\x72\xbb\x65\x59\x3c\x1a\xf1\x6d\x2e\x95\x81\xc9\x08\x60\x9c\xa1\x99\x6d\x8c\xc9\xc7\x23\xbb\x4b\xb9\xd9\x14\xeb\x90\x22\x67\xa9\xbc\xf2\x1c\x8e\x97\x36\xf4\x91\x9b\xf1\x1f\x05\xdb\x5f\x87\x5b\x55\x8a\x65\x24\x42\xf8\x16\x13\x56\x08\xb3\x74\x04\x74\x5d\x57\x55\xae\x78\x66\x12\x18\x3f\xfd\x31\x86\x90\xa4\xb1\xc1\x98\xc5\x7e\xd5\xa4\x5d\x3c\x62\x39\x85\x8b\x1d\x13\x05\xc2\xf5\x02\xc2\xa3\x7c\x7b\x56\xd7\x14\x0a\xc7\x48\x4d\x6c\x0f\x2e\x18\x75\x2c\x3e\xa3\xce\x65\xa6\x91\x00\xac\xb2\x54\x9a\x1b\xc1\xb4\xbe\x65\x5b\x74\x50\x3c\xb1\x4c\x75\xa4\x78\x6e\xb8\xcc\xec\x35\xc3\x3d\x0a\x4d\xb1\xca\x03\xb5\x77\x98\x32\xc7\x21\x9c\x36\xaa\x88\x0c\x54\x8e\x8a\x45\x5e\x6a\xaf\x42\x5d\x6b\xc3\x4c\xa1\x6f\x64\x8c\x40\xdc\x0f\x64\x3b\xa6\x1f\x91\xc5\xa8\x88\x67\x43\x73\xa5\x64\x8e\xca\x94\x7f\xcf\xd4\x1a\x81\xce\x7c\xe2\xc6\xc1\x1e\x0b\x7d\x0a\x1d\x7e\x90\x77\xf4\x9c\x63\x0d\x9b\xfb\x56\xac\x14\x92\x35\x89\x44\xcb\x6f\x9d\xd5\xd6\x32\x2e\x41\x26\x6e\xdd\x6a\x43\x51\x6d\x48\x2f\x7f\xa9\x57\x92\x1e\x8d\xaa\xae\x2f\x3b\xfc\x1e\x78\xcb\xaf\xe9\x97\x5b\xdc\x0f\x94\x8d\x14\x92\xad\x35\xb0\xa1\xe4\x87\xb2\x0c\xf4\x86\x3d\x59\xb6\x69\xaf\x1c\x23\x9e\x70\xea\xb7\xa6\x06\xd4\x1c\x71\x57\xc6\xa4\xc8\xa2\xd3\xfb\x26\x27\x70\x91\x2f\x9c\xcf\x0b\xe0\x72\x68\xa5\x73\x45\xa7\xad\x4b\x7c\xb9\x80\xb9\x8b\x80\x66\xbf\x80\xe7\xf3\x39\x6d\xed\xd3\x15\x9a\x42\x65\xf0\xac\x8f\x57\x1d\xfc\x72\xed\x72\x8e\x8c\x78\x36\xbe\xee\x4b\xf8\x55\x71\x83\xad\xf9\x61\x6f\x77\x1a\xbc\x33\xce\xe9\x47\xc3\xc7\x1d\x47\x82\x23\xd9\xd3\xc9\x42\x2a\x50\xff\x44\xc8\x77\xa8\x7c\xf8\xe0\xd5\x41\xff\x9e\x89\xda\x83\x9d\x1a\x5d\xd7\xb9\x53\x35\xb5\x83\x2b\x2e\x22\x1a\x71\x7e\xa6\x58\x0b\xba\x3f\x02\x52\xe5\xb4\x03\x5a\x1d\x5f\x2b\xc5\x6c\xc7\xc3\x8e\x29\x4b\xfc\x9e\x79\x1e\xf7\xb6\xe5\x35\x3c\x7c\xa3\x8e\xa3\x41\x49\x2a\x26\x52\xc1\xf7\x29\xec\xec\xcc\x68\xe0\x68\x5e\x0c\xd8\x87\x67\xbc\xef\x6a\x72\x06\x7a\x01\x2c\xcf\x49\xd0\xc9\xe9\xd9\xd4\xc6\x2f\x0d\x6e\xf5\x7b\xa9\xb6\xcc\x38\x5f\x07\xbe\x9a\x44\x5c\x60\x76\x26\x2b\x80\x57\x9d\x07\xd4\xde\xbf\x75\x12\x84\x5f\xd0\x4c\xc6\x5d\xbb\x8e\xa7\xee\x6b\x10\x7e\xa2\x7e\x79\x53\x36\xf8\x67\x29\xd8\x94\x1b\x29\x04\x46\xb6\xf9\x9b\x40\x4a\x0f\x1e\xe6\xdf\x1a\x2a\xad\x5d\x7e\x73\x1b\xad\x7b\xaf\xe8\xda\xd3\xfd\xb8\x64\x57\x44\x8f\x70\xe2\xf0\x13\x8b\x84\x07\xe3\xb6\x14\x1c\xd5\x18\x3b\x54\x7b\x4b\x6f\xba\x0c\x86\xc5\x2d\x17\x6c\x2d\x1c\x77\xfa\xff\xf4\x86\x76\xc6\x3c\x59\x40\xc6\x85\xd7\x94\x22\x51\x29\x5b\xfe\xd6\x6c\xad\xc9\xc8\x95\xd3\x5f\xa3\x04\x2f\x5c\x5e\x0f\x0b\x20\x67\x19\x8f\x26\x74\x10\xd8\xb9\x27\xd0\xf8\x39\x17\xd1\x07\x58\x95\xf4\x39\x8e\x63\x81\x7b\xa6\x10\x62\x64\xa2\x9d\x35\x5c\xbb\xf4\x7a\x50\x80\xff\x49\xed\x1f\x88\xd5\x83\xe2\x76\xfb\xd1\x4f\x3a\x23\x8f\x4e\xb9\x08\x00\x00")
+
+func templatesServerResponsesGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerResponsesGotmpl,
+		"templates/server/responses.gotmpl",
+	)
+}
+
+func templatesServerResponsesGotmpl() (*asset, error) {
+	bytes, err := templatesServerResponsesGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/responses.gotmpl", size: 2233, mode: os.FileMode(420), modTime: time.Unix(1792198982, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -233,7 +317,7 @@ func Asset(name string) ([]byte, error) {
 // It simplifies safe initialization of global variables.
 func MustAsset(name string) []byte {
 	a, err := Asset(name)
-	if (err != nil) {
+	if err != nil {
 		panic("asset: Asset(" + name + "): " + err.Error())
 	}
 
@@ -266,6 +350,9 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"templates/client/client.gotmpl": templatesClientClientGotmpl,
+	"templates/client/facade.gotmpl": templatesClientFacadeGotmpl,
+	"templates/client/parameter.gotmpl": templatesClientParameterGotmpl,
 	"templates/model.gotmpl": templatesModelGotmpl,
 	"templates/modelvalidator.gotmpl": templatesModelvalidatorGotmpl,
 	"templates/server/builder.gotmpl": templatesServerBuilderGotmpl,
@@ -273,17 +360,20 @@ var _bindata = map[string]func() (*asset, error){
 	"templates/server/main.gotmpl": templatesServerMainGotmpl,
 	"templates/server/operation.gotmpl": templatesServerOperationGotmpl,
 	"templates/server/parameter.gotmpl": templatesServerParameterGotmpl,
+	"templates/server/responses.gotmpl": templatesServerResponsesGotmpl,
 }
 
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"}
 // AssetDir("data/img") would return []string{"a.png", "b.png"}
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error
@@ -311,11 +401,20 @@ func AssetDir(name string) ([]string, error) {
 }
 
 type bintree struct {
-	Func func() (*asset, error)
+	Func     func() (*asset, error)
 	Children map[string]*bintree
 }
+
 var _bintree = &bintree{nil, map[string]*bintree{
 	"templates": &bintree{nil, map[string]*bintree{
+		"client": &bintree{nil, map[string]*bintree{
+			"client.gotmpl": &bintree{templatesClientClientGotmpl, map[string]*bintree{
+			}},
+			"facade.gotmpl": &bintree{templatesClientFacadeGotmpl, map[string]*bintree{
+			}},
+			"parameter.gotmpl": &bintree{templatesClientParameterGotmpl, map[string]*bintree{
+			}},
+		}},
 		"model.gotmpl": &bintree{templatesModelGotmpl, map[string]*bintree{
 		}},
 		"modelvalidator.gotmpl": &bintree{templatesModelvalidatorGotmpl, map[string]*bintree{
@@ -331,54 +430,55 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			}},
 			"parameter.gotmpl": &bintree{templatesServerParameterGotmpl, map[string]*bintree{
 			}},
+			"responses.gotmpl": &bintree{templatesServerResponsesGotmpl, map[string]*bintree{
+			}},
 		}},
 	}},
 }}
 
 // RestoreAsset restores an asset under the given directory
 func RestoreAsset(dir, name string) error {
-        data, err := Asset(name)
-        if err != nil {
-                return err
-        }
-        info, err := AssetInfo(name)
-        if err != nil {
-                return err
-        }
-        err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-        if err != nil {
-                return err
-        }
-        err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-        if err != nil {
-                return err
-        }
-        err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-        if err != nil {
-                return err
-        }
-        return nil
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
 // RestoreAssets restores an asset under the given directory recursively
 func RestoreAssets(dir, name string) error {
-        children, err := AssetDir(name)
-        // File
-        if err != nil {
-                return RestoreAsset(dir, name)
-        }
-        // Dir
-        for _, child := range children {
-                err = RestoreAssets(dir, filepath.Join(name, child))
-                if err != nil {
-                        return err
-                }
-        }
-        return nil
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func _filePath(dir, name string) string {
-        cannonicalName := strings.Replace(name, "\\", "/", -1)
-        return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
 }
-
@@ -0,0 +1,250 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/go-swagger/go-swagger/swag"
+)
+
+var (
+	clientFacadeTemplate    *template.Template
+	clientTemplate          *template.Template
+	clientParameterTemplate *template.Template
+)
+
+// GenerateClient generates a client library for a swagger spec document.
+// It renders a facade in the client package with a typed client per tag,
+// each operation gets a parameter struct and a method on the client for its tag.
+func GenerateClient(name string, operationIDs []string, opts GenOpts) error {
+	if err := configureGenerator(opts); err != nil {
+		return err
+	}
+
+	// Load the spec
+	specPath, specDoc, err := loadSpec(opts.Spec)
+	if err != nil {
+		return err
+	}
+	types, err := newTypeResolver(opts, specDoc)
+	if err != nil {
+		return err
+	}
+
+	if len(operationIDs) == 0 {
+		operationIDs = specDoc.OperationIDs()
+	}
+
+	operations := make(map[string]spec.Operation)
+	for _, k := range operationIDs {
+		op, ok := specDoc.OperationForName(k)
+		if !ok {
+			return fmt.Errorf("operation %q not found in %s", k, specPath)
+		}
+		operations[k] = *op
+	}
+
+	if name == "" {
+		if specDoc.Spec().Info != nil && specDoc.Spec().Info.Title != "" {
+			name = swag.ToGoName(specDoc.Spec().Info.Title)
+		} else {
+			name = "swagger"
+		}
+	}
+
+	generator := clientGenerator{
+		Name:          name,
+		SpecDoc:       specDoc,
+		Types:         types,
+		Operations:    operations,
+		Target:        opts.Target,
+		APIPackage:    opts.APIPackage,
+		ModelsPackage: opts.ModelPackage,
+		ClientPackage: opts.ClientPackage,
+		DumpData:      opts.DumpData,
+	}
+
+	return generator.Generate()
+}
+
+type clientGenerator struct {
+	Name          string
+	SpecDoc       *spec.Document
+	Types         *typeResolver
+	APIPackage    string
+	ModelsPackage string
+	ClientPackage string
+	Operations    map[string]spec.Operation
+	Target        string
+	DumpData      bool
+}
+
+func (c *clientGenerator) Generate() error {
+	app := c.makeCodegenApp()
+
+	if c.DumpData {
+		bb, _ := json.MarshalIndent(swag.ToDynamicJSON(app), "", "  ")
+		fmt.Fprintln(os.Stdout, string(bb))
+		return nil
+	}
+
+	for _, opg := range app.OperationGroups {
+		for _, op := range opg.Operations {
+			if err := c.generateParameters(&op); err != nil {
+				return fmt.Errorf("parameters: %s", err)
+			}
+		}
+		if err := c.generateGroupClient(&opg); err != nil {
+			return fmt.Errorf("client: %s", err)
+		}
+	}
+
+	if err := c.generateFacade(&app); err != nil {
+		return fmt.Errorf("facade: %s", err)
+	}
+
+	return nil
+}
+
+func (c *clientGenerator) generateParameters(op *genOperation) error {
+	if len(op.Params) == 0 {
+		log.Println("no parameters for operation", op.Package+"."+op.ClassName)
+		return nil
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := clientParameterTemplate.Execute(buf, op); err != nil {
+		return err
+	}
+	log.Println("rendered client parameters template:", op.Package+"."+op.ClassName+"Params")
+	return writeToFile(filepath.Join(c.Target, c.ClientPackage, op.Package), op.Name+"Parameters", buf.Bytes())
+}
+
+func (c *clientGenerator) generateGroupClient(opg *genOperationGroup) error {
+	buf := bytes.NewBuffer(nil)
+	if err := clientTemplate.Execute(buf, opg); err != nil {
+		return err
+	}
+	log.Println("rendered operation group client template:", opg.Name+"."+opg.ClassName+"Client")
+	return writeToFile(filepath.Join(c.Target, c.ClientPackage, opg.Name), opg.Name+"Client", buf.Bytes())
+}
+
+func (c *clientGenerator) generateFacade(app *genApp) error {
+	buf := bytes.NewBuffer(nil)
+	if err := clientFacadeTemplate.Execute(buf, app); err != nil {
+		return err
+	}
+	log.Println("rendered client facade template:", app.Package+"."+app.AppName)
+	return writeToFile(filepath.Join(c.Target, c.ClientPackage), app.AppName+"Client", buf.Bytes())
+}
+
+// operationPaths builds a lookup table of operation id to http method and path template
+func (c *clientGenerator) operationPaths() map[string][2]string {
+	result := make(map[string][2]string)
+	for method, paths := range c.SpecDoc.Operations() {
+		for path, op := range paths {
+			result[op.ID] = [2]string{method, path}
+		}
+	}
+	return result
+}
+
+// paramsFor gets the parameters of the operation at the method and path, the ones declared on the path included,
+// they're sorted by name so the generated code doesn't change between runs
+func paramsFor(specDoc *spec.Document, method, path string) []spec.Parameter {
+	params := specDoc.ParamsFor(method, path)
+	names := make([]string, 0, len(params))
+	for k := range params {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	result := make([]spec.Parameter, 0, len(names))
+	for _, k := range names {
+		result = append(result, params[k])
+	}
+	return result
+}
+
+func (c *clientGenerator) makeCodegenApp() genApp {
+	receiver := "a"
+	appName := swag.ToGoName(c.Name)
+	clientImport := filepath.ToSlash(filepath.Join(baseImport(c.Target), c.ClientPackage))
+
+	jsonb, _ := json.MarshalIndent(c.SpecDoc.Spec(), "", "  ")
+	paths := c.operationPaths()
+
+	groups := make(map[string][]genOperation)
+	for on, o := range c.Operations {
+		if mp, ok := paths[on]; ok {
+			o.Parameters = paramsFor(c.SpecDoc, mp[0], mp[1])
+		}
+		tags := o.Tags
+		if len(tags) == 0 {
+			tags = []string{c.APIPackage}
+		}
+		for _, tag := range tags {
+			op := makeCodegenOperation(c.Types, on, tag, c.ModelsPackage, "", filepath.Join(c.Target, c.ClientPackage), o, false)
+			if mp, ok := paths[on]; ok {
+				op.Method = mp[0]
+				op.Path = mp[1]
+			}
+			groups[tag] = append(groups[tag], op)
+		}
+	}
+
+	var opGroups []genOperationGroup
+	var defaultImports []string
+	for k, v := range groups {
+		sort.Sort(genOperationSlice(v))
+		opGroups = append(opGroups, genOperationGroup{
+			Name:           k,
+			ClassName:      swag.ToGoName(k),
+			HumanClassName: swag.ToHumanNameLower(k),
+			ReceiverName:   receiver,
+			Operations:     v,
+			DefaultImports: v[0].DefaultImports,
+		})
+		defaultImports = append(defaultImports, filepath.ToSlash(filepath.Join(clientImport, k)))
+	}
+	sort.Sort(genOperationGroupSlice(opGroups))
+	sort.Strings(defaultImports)
+
+	return genApp{
+		Package:         c.ClientPackage,
+		ReceiverName:    receiver,
+		AppName:         appName,
+		HumanAppName:    swag.ToHumanNameLower(c.Name),
+		Name:            swag.ToJSONName(c.Name),
+		ExternalDocs:    c.SpecDoc.Spec().ExternalDocs,
+		Info:            c.SpecDoc.Spec().Info,
+		DefaultImports:  defaultImports,
+		OperationGroups: opGroups,
+		SwaggerJSON:     fmt.Sprintf("%#v", jsonb),
+	}
+}
+
+type genOperationGroupSlice []genOperationGroup
+
+func (s genOperationGroupSlice) Len() int { return len(s) }
+func (s genOperationGroupSlice) Less(i, j int) bool {
+	return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name)
+}
+func (s genOperationGroupSlice) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+type genOperationGroup struct {
+	Name           string
+	ClassName      string
+	HumanClassName string
+	ReceiverName   string
+	Operations     []genOperation
+	DefaultImports []string
+}
@@ -0,0 +1,358 @@
+package generator
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	petsHierarchySpec    = "../fixtures/codegen/pets-hierarchy.json"
+	petstoreExpandedSpec = "../fixtures/petstores/petstore-expanded.json"
+
+	// the generated client package is three levels below the generated dir
+	petsHierarchySpecFromClient = "../../../" + petsHierarchySpec
+)
+
+// testGeneratedCode generates the code for the spec in a package below this one
+// and runs the test source in the generated package pkg, so the tests exercise the generated code itself
+func testGeneratedCode(t *testing.T, specFile, pkg, testSource string, generate func(opts GenOpts) error) {
+	if testing.Short() {
+		t.Skip("builds the generated code")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("the go tool is needed to build the generated code")
+	}
+
+	target, err := ioutil.TempDir(".", "generated")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(target)
+
+	// the operations are generated relative to the working directory, so the code is generated from within the target
+	specPath, err := filepath.Abs(specFile)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	wd, err := os.Getwd()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	if !assert.NoError(t, os.Chdir(target)) {
+		t.FailNow()
+	}
+	err = generate(GenOpts{
+		Spec:          specPath,
+		Target:        ".",
+		APIPackage:    "operations",
+		ModelPackage:  "models",
+		ServerPackage: "restapi",
+		ClientPackage: "client",
+	})
+	if !assert.NoError(t, os.Chdir(wd)) || !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// all the generated packages build, not only the one with the tests
+	out, err := exec.Command(goTool, "build", "./"+filepath.ToSlash(target)+"/...").CombinedOutput()
+	if !assert.NoError(t, err, string(out)) {
+		t.FailNow()
+	}
+
+	dir := filepath.Join(target, filepath.FromSlash(pkg))
+	if !assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "generated_test.go"), []byte(testSource), 0644)) {
+		t.FailNow()
+	}
+	out, err = exec.Command(goTool, "test", "./"+filepath.ToSlash(dir)).CombinedOutput()
+	assert.NoError(t, err, string(out))
+}
+
+// skipOutsideGopath skips the test when the generated packages can't import each other,
+// because the generator derives their import paths from the gopath
+func skipOutsideGopath(t *testing.T) {
+	wd, err := os.Getwd()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	for _, gp := range filepath.SplitList(os.Getenv("GOPATH")) {
+		if strings.HasPrefix(wd, filepath.Join(gp, "src")) {
+			return
+		}
+	}
+	t.Skip("the generated code imports its packages from the gopath")
+}
+
+func TestGeneratedModels(t *testing.T) {
+	testGeneratedCode(t, petsHierarchySpec, "models", generatedModelsTest, func(opts GenOpts) error {
+		return GenerateModel(nil, true, true, opts)
+	})
+}
+
+func TestGeneratedServer(t *testing.T) {
+	skipOutsideGopath(t)
+	testGeneratedCode(t, petsHierarchySpec, "restapi/operations", generatedServerTest, func(opts GenOpts) error {
+		if err := GenerateModel(nil, true, true, opts); err != nil {
+			return err
+		}
+		if err := GenerateServerOperation(nil, nil, true, true, true, opts); err != nil {
+			return err
+		}
+		return GenerateSupport("", nil, nil, true, opts)
+	})
+}
+
+func TestGeneratedPetstoreExpanded(t *testing.T) {
+	skipOutsideGopath(t)
+	testGeneratedCode(t, petstoreExpandedSpec, "models", generatedPetstoreTest, func(opts GenOpts) error {
+		if err := GenerateModel(nil, true, true, opts); err != nil {
+			return err
+		}
+		return GenerateServerOperation(nil, nil, true, true, true, opts)
+	})
+}
+
+func TestGeneratedClient(t *testing.T) {
+	skipOutsideGopath(t)
+	testGeneratedCode(t, petsHierarchySpec, "client/pets", generatedClientTest, func(opts GenOpts) error {
+		if err := GenerateModel(nil, true, true, opts); err != nil {
+			return err
+		}
+		return GenerateClient("", nil, opts)
+	})
+}
+
+// generatedModelsTest runs against the models generated for the pets hierarchy fixture
+const generatedModelsTest = `package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/errors"
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/strfmt"
+)
+
+func TestUnmarshalPet(t *testing.T) {
+	pet, err := UnmarshalPet(strings.NewReader(` + "`" + `{"petType": "Dog", "name": "rex", "packSize": 4}` + "`" + `), httpkit.JSONConsumer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dog, ok := pet.(*Dog)
+	if !ok {
+		t.Fatalf("expected a *Dog, got %T", pet)
+	}
+	if dog.Name != "rex" || dog.PackSize != 4 || dog.Discriminator() != "Dog" {
+		t.Errorf("unexpected dog %+v", dog)
+	}
+
+	// a value the hierarchy doesn't know fails like an enum
+	_, err = UnmarshalPet(strings.NewReader(` + "`" + `{"petType": "Fish", "name": "nemo"}` + "`" + `), httpkit.JSONConsumer())
+	verr, ok := err.(*errors.Validation)
+	if !ok {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	if verr.Name != "petType" || verr.Value != "Fish" {
+		t.Errorf("unexpected error %v", verr)
+	}
+
+	// the properties of the base type are unmarshalled into the definition the discriminator tells
+	var household Household
+	if err := httpkit.JSONConsumer().Consume(bytes.NewReader([]byte(` + "`" + `{"favorite": {"petType": "Cat", "name": "tom"}, "pets": [{"petType": "Dog", "name": "rex"}, {"petType": "Cat", "name": "felix"}]}` + "`" + `)), &household); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := household.Favorite.(*Cat); !ok {
+		t.Errorf("expected the favorite to be a *Cat, got %T", household.Favorite)
+	}
+	if len(household.Pets) != 2 {
+		t.Fatalf("expected 2 pets, got %d", len(household.Pets))
+	}
+	if _, ok := household.Pets[0].(*Dog); !ok {
+		t.Errorf("expected a *Dog, got %T", household.Pets[0])
+	}
+	if _, ok := household.Pets[1].(*Cat); !ok {
+		t.Errorf("expected a *Cat, got %T", household.Pets[1])
+	}
+
+	// the properties of the base type can be read without knowing the definition
+	var names []string
+	for _, pet := range household.Pets {
+		names = append(names, pet.GetPetType()+":"+pet.GetName())
+	}
+	if strings.Join(names, ",") != "Dog:rex,Cat:felix" {
+		t.Errorf("unexpected pets %v", names)
+	}
+}
+
+func TestCatRoundTrip(t *testing.T) {
+	cat := Cat{
+		BasePet:      BasePet{Name: "tom"},
+		Resource:     Resource{ID: 1, Links: []string{"/cats/1"}},
+		HuntingSkill: "lazy",
+	}
+	data, err := json.Marshal(cat)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the embedded definitions and the properties end up in one object, the petType defaults to Cat
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"petType":      "Cat",
+		"name":         "tom",
+		"id":           float64(1),
+		"links":        []interface{}{"/cats/1"},
+		"huntingSkill": "lazy",
+	}
+	if !reflect.DeepEqual(expected, fields) {
+		t.Errorf("expected %v, got %v", expected, fields)
+	}
+
+	var read Cat
+	if err := json.Unmarshal(data, &read); err != nil {
+		t.Fatal(err)
+	}
+	cat.PetType = "Cat"
+	if !reflect.DeepEqual(cat, read) {
+		t.Errorf("expected %+v, got %+v", cat, read)
+	}
+
+	// the embedded definitions are validated too
+	if err := read.Validate(strfmt.Default); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	read.Name = ""
+	if err := read.Validate(strfmt.Default); err == nil {
+		t.Error("expected the name of the pet to be validated")
+	}
+}
+`
+
+// generatedServerTest runs against the server generated for the pets hierarchy fixture
+const generatedServerTest = `package operations
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/spec"
+)
+
+func TestServeWithDocs(t *testing.T) {
+	swaggerSpec, err := spec.New(json.RawMessage(` + "`" + `{"swagger": "2.0", "info": {"title": "pets", "version": "1"}, "basePath": "/api", "paths": {}}` + "`" + `), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the api is fresh, serving it creates the context
+	handler := NewPetsHierarchyAPI(swaggerSpec).ServeWithDocs()
+
+	for _, pth := range []string{"/api/docs/", "/api/docs/swagger-ui-bundle.js"} {
+		request, _ := http.NewRequest("GET", pth, nil)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		if recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), "swagger-ui") {
+			t.Errorf("expected swagger-ui at %s, got %d", pth, recorder.Code)
+		}
+	}
+
+	request, _ := http.NewRequest("GET", "/api/swagger.json", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusOK {
+		t.Errorf("expected the spec to be served, got %d", recorder.Code)
+	}
+}
+`
+
+// generatedPetstoreTest runs against the models generated for the expanded petstore fixture
+const generatedPetstoreTest = `package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/strfmt"
+)
+
+func TestNewPet(t *testing.T) {
+	// new pet refers to pet with a bare $ref, it embeds the pet
+	var pet NewPet
+	if err := json.Unmarshal([]byte(` + "`" + `{"id": 1, "name": "rex", "tag": "dog"}` + "`" + `), &pet); err != nil {
+		t.Fatal(err)
+	}
+	if pet.ID != 1 || pet.Name != "rex" || pet.Tag != "dog" {
+		t.Errorf("unexpected pet %+v", pet)
+	}
+	if err := pet.Validate(strfmt.Default); err != nil {
+		t.Error(err)
+	}
+
+	b, err := json.Marshal(pet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var back NewPet
+	if err := json.Unmarshal(b, &back); err != nil || back != pet {
+		t.Errorf("expected %+v to round trip, got %+v (%v)", pet, back, err)
+	}
+}
+`
+
+// generatedClientTest runs against the client generated for the pets hierarchy fixture
+const generatedClientTest = `package pets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	httpclient "github.com/go-swagger/go-swagger/httpkit/client"
+	"github.com/go-swagger/go-swagger/spec"
+)
+
+func TestGetPet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/pets/rex" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(` + "`" + `{"petType": "Dog", "name": "rex", "packSize": 4}` + "`" + `))
+	}))
+	defer server.Close()
+
+	doc, err := spec.Load("` + petsHierarchySpecFromClient + `")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := httpclient.New(doc)
+	u, _ := url.Parse(server.URL)
+	rt.Host = u.Host
+
+	// the name parameter is declared on the path, the params of the operation have it too
+	pet, err := New(rt).GetPet(&GetPetParams{Name: "rex"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pet.Discriminator() != "Dog" {
+		t.Errorf("expected a dog, got %+v", pet)
+	}
+}
+`
@@ -0,0 +1,134 @@
+package generator
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/go-swagger/go-swagger/swag"
+)
+
+// discriminatedType is a definition that takes part in a hierarchy with a discriminator
+type discriminatedType struct {
+	// Base is the definition with the discriminator
+	Base string
+	// Parent is the definition this one extends with allOf, it's empty for the base definition
+	Parent string
+	// Property is the name of the discriminator property
+	Property string
+}
+
+// discriminatedTypesOf finds the definitions with a discriminator and the definitions that extend them, by definition name.
+// A definition extends another one when it has a $ref to it in its allOf,
+// the value of the discriminator for a definition is the name of the definition.
+func discriminatedTypesOf(swspec *spec.Swagger) map[string]discriminatedType {
+	result := make(map[string]discriminatedType)
+	for name, schema := range swspec.Definitions {
+		if schema.Discriminator != "" {
+			result[name] = discriminatedType{Base: name, Property: schema.Discriminator}
+		}
+	}
+
+	// the definitions are extended in any order, so this goes on until nothing gets added
+	for found := len(result) > 0; found; {
+		found = false
+		for name, schema := range swspec.Definitions {
+			if _, ok := result[name]; ok {
+				continue
+			}
+			for _, member := range schema.AllOf {
+				parent, ok := result[refName(&member)]
+				if !ok {
+					continue
+				}
+				result[name] = discriminatedType{Base: parent.Base, Parent: refName(&member), Property: parent.Property}
+				found = true
+				break
+			}
+		}
+	}
+	return result
+}
+
+// refName gets the name of the definition a schema refers to, it's empty when the schema isn't a $ref.
+// A bare $ref like "pet" has no fragment, it refers to the definition by its name.
+func refName(schema *spec.Schema) string {
+	if schema == nil || schema.Ref.GetURL() == nil {
+		return ""
+	}
+	u := schema.Ref.GetURL()
+	pth := u.Fragment
+	if pth == "" {
+		pth = u.Path
+	}
+	name := filepath.Base(pth)
+	if name == "." || name == "/" {
+		return ""
+	}
+	return name
+}
+
+// baseStructName gets the name of the struct generated for a definition with a discriminator,
+// the name of the definition itself goes to the interface for the hierarchy
+func baseStructName(name string) string {
+	return "Base" + swag.ToGoName(name)
+}
+
+// structNameFor gets the struct generated for a definition,
+// for a definition with a discriminator that's the base struct and not the interface
+func (t *typeResolver) structNameFor(name string) string {
+	if dt, ok := t.discriminatedTypes[name]; ok && dt.Base == name {
+		return baseStructName(name)
+	}
+	return swag.ToGoName(name)
+}
+
+// polymorphicBase gets the base definition when the schema refers to a definition with a discriminator,
+// or when it's an array of those. Values of these schemas are unmarshalled by the discriminator.
+func (t *typeResolver) polymorphicBase(schema *spec.Schema) (string, bool) {
+	if schema == nil {
+		return "", false
+	}
+	if schema.Items != nil && schema.Items.Schema != nil {
+		schema = schema.Items.Schema
+	}
+	name := refName(schema)
+	if dt, ok := t.discriminatedTypes[name]; ok && dt.Base == name {
+		return name, true
+	}
+	return "", false
+}
+
+// unmarshallerFor gets the function that unmarshals the values of a schema by their discriminator
+func (t *typeResolver) unmarshallerFor(schema *spec.Schema, modelsPkg string) string {
+	base, ok := t.polymorphicBase(schema)
+	if !ok {
+		return ""
+	}
+	if modelsPkg != "" {
+		return modelsPkg + ".Unmarshal" + swag.ToGoName(base)
+	}
+	return "Unmarshal" + swag.ToGoName(base)
+}
+
+// genSubType is a definition in a hierarchy with the value of the discriminator for it
+type genSubType struct {
+	Value      string //`json:"value,omitempty"`
+	StructName string //`json:"structName,omitempty"`
+}
+
+// subTypesOf gets the definitions in the hierarchy of a base definition, the base definition goes first
+func (t *typeResolver) subTypesOf(base string) []genSubType {
+	result := []genSubType{{Value: base, StructName: baseStructName(base)}}
+	var names []string
+	for name, dt := range t.discriminatedTypes {
+		if dt.Base == base && name != base {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		result = append(result, genSubType{Value: name, StructName: swag.ToGoName(name)})
+	}
+	return result
+}
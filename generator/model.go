@@ -20,22 +20,21 @@ var (
 	modelValidatorTemplate *template.Template
 )
 
-func init() {
-	bv, _ := Asset("templates/modelvalidator.gotmpl")
-	modelValidatorTemplate = template.Must(template.New("modelvalidator").Parse(string(bv)))
-
-	bm, _ := Asset("templates/model.gotmpl")
-	modelTemplate = template.Must(template.New("model").Parse(string(bm)))
-
-}
-
 // GenerateModel generates a model file for a schema defintion
 func GenerateModel(modelNames []string, includeModel, includeValidator bool, opts GenOpts) error {
+	if err := configureGenerator(opts); err != nil {
+		return err
+	}
+
 	// Load the spec
 	specPath, specDoc, err := loadSpec(opts.Spec)
 	if err != nil {
 		return err
 	}
+	types, err := newTypeResolver(opts, specDoc)
+	if err != nil {
+		return err
+	}
 
 	if len(modelNames) == 0 {
 		for k := range specDoc.Spec().Definitions {
@@ -49,12 +48,17 @@ func GenerateModel(modelNames []string, includeModel, includeValidator bool, opt
 		if !ok {
 			return fmt.Errorf("model %q not found in definitions in %s", modelName, specPath)
 		}
+		if ct, ok := types.customDefinition(modelName); ok {
+			log.Printf("skipped model %s, it's mapped to %s", modelName, ct.GoType)
+			continue
+		}
 
 		// generate files
 		generator := modelGenerator{
 			Name:             modelName,
 			Model:            model,
 			SpecDoc:          specDoc,
+			Types:            types,
 			Target:           filepath.Join(opts.Target, opts.ModelPackage),
 			IncludeModel:     includeModel,
 			IncludeValidator: includeValidator,
@@ -73,6 +77,7 @@ type modelGenerator struct {
 	Name             string
 	Model            spec.Schema
 	SpecDoc          *spec.Document
+	Types            *typeResolver
 	Target           string
 	IncludeModel     bool
 	IncludeValidator bool
@@ -81,7 +86,7 @@ type modelGenerator struct {
 }
 
 func (m *modelGenerator) Generate() error {
-	mod := makeCodegenModel(m.Name, m.Target, m.Model, m.SpecDoc)
+	mod := makeCodegenModel(m.Types, m.Name, m.Target, m.Model, m.SpecDoc)
 	if m.DumpData {
 		bb, _ := json.MarshalIndent(swag.ToDynamicJSON(mod), "", " ")
 		fmt.Fprintln(os.Stdout, string(bb))
@@ -126,8 +131,29 @@ func (m *modelGenerator) generateModel() error {
 	return writeToFile(m.Target, m.Name, buf.Bytes())
 }
 
-func makeCodegenModel(name, pkg string, schema spec.Schema, specDoc *spec.Document) *genModel {
+// validatedDefinitionsOf finds the definitions whose models have validations.
+// A model validates the properties that refer to those definitions, so this goes on until nothing gets added.
+func (t *typeResolver) validatedDefinitionsOf(specDoc *spec.Document) map[string]bool {
+	result := make(map[string]bool)
+	t.validatedDefinitions = result
+	for found := true; found; {
+		found = false
+		for name, schema := range specDoc.Spec().Definitions {
+			if _, ok := t.customDefinition(name); ok || result[name] {
+				continue
+			}
+			if makeCodegenModel(t, name, "", schema, specDoc).HasValidations {
+				result[name] = true
+				found = true
+			}
+		}
+	}
+	return result
+}
+
+func makeCodegenModel(types *typeResolver, name, pkg string, schema spec.Schema, specDoc *spec.Document) *genModel {
 	receiver := "m"
+	dt, discriminated := types.discriminatedTypes[name]
 	props := make(map[string]genModelProperty)
 	for pn, p := range schema.Properties {
 		var required bool
@@ -138,6 +164,7 @@ func makeCodegenModel(name, pkg string, schema spec.Schema, specDoc *spec.Docume
 			}
 		}
 		props[swag.ToJSONName(pn)] = makeGenModelProperty(
+			types,
 			"\""+pn+"\"",
 			swag.ToJSONName(pn),
 			swag.ToGoName(pn),
@@ -147,30 +174,66 @@ func makeCodegenModel(name, pkg string, schema spec.Schema, specDoc *spec.Docume
 			p,
 			required)
 	}
+	// the definitions allOf refers to are embedded, the properties of inline schemas are copied
+	var embeds []genEmbedded
 	for _, p := range schema.AllOf {
-		if p.Ref.GetURL() != nil {
-			tn := filepath.Base(p.Ref.GetURL().Fragment)
-			p = specDoc.Spec().Definitions[tn]
+		if tn := refName(&p); tn != "" {
+			embeds = append(embeds, makeGenEmbedded(types, tn))
+			continue
 		}
-		mod := makeCodegenModel(name, pkg, p, specDoc)
+		mod := makeCodegenModel(types, name, pkg, p, specDoc)
 		if mod != nil {
 			for _, prop := range mod.Properties {
 				props[prop.ParamName] = prop
 			}
+			embeds = append(embeds, mod.Embeds...)
 		}
 	}
 
 	var properties []genModelProperty
-	var hasValidations bool
+	var hasValidations, hasPolymorphicProperties bool
 	for _, v := range props {
 		if v.HasValidations {
 			hasValidations = v.HasValidations
 		}
+		if v.IsPolymorphic {
+			hasPolymorphicProperties = true
+		}
 		properties = append(properties, v)
 	}
 
 	sort.Sort(genModelPropertySlice(properties))
 
+	// the definition with the discriminator becomes an interface, its properties go to a base struct
+	structName := swag.ToGoName(name)
+	var baseType, discriminator, discriminatorField, discriminatorXMLName string
+	var subTypes []genSubType
+	if discriminated {
+		baseType = swag.ToGoName(dt.Base)
+		discriminator = swag.ToJSONName(dt.Property)
+		discriminatorField = swag.ToGoName(dt.Property)
+		discriminatorXMLName = discriminator
+		if dt.Parent == "" {
+			structName = baseStructName(name)
+			subTypes = types.subTypesOf(name)
+			if prop, ok := props[discriminator]; ok && prop.XMLName != "" {
+				discriminatorXMLName = prop.XMLName
+			}
+		}
+	}
+	for _, e := range embeds {
+		if !e.IsCustomType {
+			hasValidations = true
+		}
+	}
+
+	defaultImports := []string{"github.com/go-swagger/go-swagger/strfmt"}
+	var xmlName string
+	if schema.XML != nil {
+		xmlName = xmlElementName(name, schema.XML)
+		defaultImports = append(defaultImports, "encoding/xml")
+	}
+
 	return &genModel{
 		Package:        filepath.Base(pkg),
 		ClassName:      swag.ToGoName(name),
@@ -180,8 +243,21 @@ func makeCodegenModel(name, pkg string, schema spec.Schema, specDoc *spec.Docume
 		Description:    schema.Description,
 		DocString:      modelDocString(swag.ToGoName(name), schema.Description),
 		HumanClassName: swag.ToHumanNameLower(swag.ToGoName(name)),
-		DefaultImports: []string{"github.com/go-swagger/go-swagger/strfmt"},
+		DefaultImports: defaultImports,
+		Imports:        types.customImports(),
 		HasValidations: hasValidations,
+		XMLName:        xmlName,
+
+		StructName:               structName,
+		Embeds:                   embeds,
+		BaseType:                 baseType,
+		IsBaseType:               discriminated && dt.Parent == "",
+		Discriminator:            discriminator,
+		DiscriminatorField:       discriminatorField,
+		DiscriminatorValue:       name,
+		DiscriminatorXMLName:     discriminatorXMLName,
+		SubTypes:                 subTypes,
+		HasPolymorphicProperties: hasPolymorphicProperties,
 	}
 }
 
@@ -197,31 +273,58 @@ type genModel struct {
 	Imports        map[string]string  //`json:"imports,omitempty"`
 	DefaultImports []string           //`json:"defaultImports,omitempty"`
 	HasValidations bool               //`json:"hasValidatins,omitempty"`
+	XMLName        string             //`json:"xmlName,omitempty"`
+
+	StructName               string        //`json:"structName,omitempty"` // differs from the class name for the base of a hierarchy
+	Embeds                   []genEmbedded //`json:"embeds,omitempty"`
+	BaseType                 string        //`json:"baseType,omitempty"`   // the interface of the hierarchy
+	IsBaseType               bool          //`json:"isBaseType,omitempty"`
+	Discriminator            string        //`json:"discriminator,omitempty"`
+	DiscriminatorField       string        //`json:"discriminatorField,omitempty"`
+	DiscriminatorValue       string        //`json:"discriminatorValue,omitempty"`
+	DiscriminatorXMLName     string        //`json:"discriminatorXmlName,omitempty"`
+	SubTypes                 []genSubType  //`json:"subTypes,omitempty"`
+	HasPolymorphicProperties bool          //`json:"hasPolymorphicProperties,omitempty"`
+}
+
+// genEmbedded is a definition a model embeds because it's a $ref in its allOf
+type genEmbedded struct {
+	TypeName     string //`json:"typeName,omitempty"`
+	FieldName    string //`json:"fieldName,omitempty"`
+	IsCustomType bool   //`json:"isCustomType,omitempty"` // mapped to a go type from the generator options, it has no Validate method
+}
+
+func makeGenEmbedded(types *typeResolver, name string) genEmbedded {
+	if ct, ok := types.customDefinition(name); ok {
+		return genEmbedded{TypeName: ct.GoType, FieldName: ct.GoType[strings.LastIndex(ct.GoType, ".")+1:], IsCustomType: true}
+	}
+	tn := types.structNameFor(name)
+	return genEmbedded{TypeName: tn, FieldName: tn}
 }
 
 func modelDocString(className, desc string) string {
 	return commentedLines(fmt.Sprintf("%s %s", className, desc))
 }
 
-func makeGenModelProperty(path, paramName, accessor, receiver, indexVar, valueExpression string, schema spec.Schema, required bool) genModelProperty {
+func makeGenModelProperty(types *typeResolver, path, paramName, accessor, receiver, indexVar, valueExpression string, schema spec.Schema, required bool) genModelProperty {
 	// log.Printf("property: (path %s) (param %s) (accessor %s) (receiver %s) (indexVar %s) (expr %s) required %t", path, paramName, accessor, receiver, indexVar, valueExpression, required)
 	ex := ""
 	if schema.Example != nil {
 		ex = fmt.Sprintf("%#v", schema.Example)
 	}
 
-	ctx := makeGenValidations(modelValidations(path, paramName, accessor, indexVar, valueExpression, "", required, schema))
+	ctx := makeGenValidations(modelValidations(types, path, paramName, accessor, indexVar, valueExpression, "", required, schema))
 
 	singleSchemaSlice := schema.Items != nil && schema.Items.Schema != nil
 	var items []genModelProperty
 	if singleSchemaSlice {
 		ctx.HasSliceValidations = true
 		items = []genModelProperty{
-			makeGenModelProperty("fmt.Sprintf(\"%s.%v\", "+path+", "+indexVar+")", paramName, accessor, receiver, indexVar+"i", valueExpression+"["+indexVar+"]", *schema.Items.Schema, false),
+			makeGenModelProperty(types, "fmt.Sprintf(\"%s.%v\", "+path+", "+indexVar+")", paramName, accessor, receiver, indexVar+"i", valueExpression+"["+indexVar+"]", *schema.Items.Schema, false),
 		}
 	} else if schema.Items != nil {
 		for _, s := range schema.Items.Schemas {
-			items = append(items, makeGenModelProperty("fmt.Sprintf(\"%s.%v\", "+path+", "+indexVar+")", paramName, accessor, receiver, indexVar+"i", valueExpression+"["+indexVar+"]", s, false))
+			items = append(items, makeGenModelProperty(types, "fmt.Sprintf(\"%s.%v\", "+path+", "+indexVar+")", paramName, accessor, receiver, indexVar+"i", valueExpression+"["+indexVar+"]", s, false))
 		}
 	}
 
@@ -231,21 +334,17 @@ func makeGenModelProperty(path, paramName, accessor, receiver, indexVar, valueEx
 	hasAdditionalItems := allowsAdditionalItems && !singleSchemaSlice
 	var additionalItems *genModelProperty
 	if schema.AdditionalItems != nil && schema.AdditionalItems.Schema != nil {
-		it := makeGenModelProperty("fmt.Sprintf(\"%s.%v\", "+path+", "+indexVar+")", paramName, accessor, receiver, indexVar+"i", valueExpression+"["+indexVar+"]", *schema.AdditionalItems.Schema, false)
+		it := makeGenModelProperty(types, "fmt.Sprintf(\"%s.%v\", "+path+", "+indexVar+")", paramName, accessor, receiver, indexVar+"i", valueExpression+"["+indexVar+"]", *schema.AdditionalItems.Schema, false)
 		additionalItems = &it
 	}
 
 	ctx.HasSliceValidations = len(items) > 0 || hasAdditionalItems
 	ctx.HasValidations = ctx.HasValidations || ctx.HasSliceValidations
-
-	xmlName := paramName
-	if schema.XML != nil {
-		if schema.XML.Name != "" {
-			xmlName = schema.XML.Name
-			if schema.XML.Attribute {
-				xmlName += ",attr"
-			}
-		}
+	// the models of the definitions a property refers to validate themselves
+	if ctx.IsPolymorphic && !ctx.IsContainer {
+		ctx.HasValidations = true
+	} else if name := refName(&schema); name != "" && types.validatedDefinitions[name] {
+		ctx.HasValidations = true
 	}
 
 	return genModelProperty{
@@ -255,7 +354,7 @@ func makeGenModelProperty(path, paramName, accessor, receiver, indexVar, valueEx
 		DocString:       propertyDocString(accessor, schema.Description, ex),
 		Description:     schema.Description,
 		ReceiverName:    receiver,
-		IsComplexObject: !ctx.IsPrimitive && !ctx.IsCustomFormatter && !ctx.IsContainer,
+		IsComplexObject: !ctx.IsPrimitive && !ctx.IsCustomFormatter && !ctx.IsContainer && !types.isCustomType(ctx.Type),
 
 		HasAdditionalItems:    hasAdditionalItems,
 		AllowsAdditionalItems: allowsAdditionalItems,
@@ -265,8 +364,57 @@ func makeGenModelProperty(path, paramName, accessor, receiver, indexVar, valueEx
 		ItemsLen:          len(items),
 		SingleSchemaSlice: singleSchemaSlice,
 
-		XMLName: xmlName,
+		XMLName: xmlPropertyTag(paramName, schema),
+	}
+}
+
+// xmlElementName gets the name of the xml element for a schema, prefixed with its namespace when it has one
+func xmlElementName(name string, xmlObject *spec.XMLObject) string {
+	if xmlObject == nil {
+		return name
+	}
+	if xmlObject.Name != "" {
+		name = xmlObject.Name
+	}
+	if xmlObject.Namespace != "" {
+		name = xmlObject.Namespace + " " + name
+	}
+	return name
+}
+
+// xmlPropertyTag gets the value of the xml struct tag for a property from the xml object of its schema.
+// Attributes get the attr option and the items of wrapped arrays are nested in an element for the property.
+// Prefixes aren't supported by encoding/xml, only the namespace is used.
+func xmlPropertyTag(paramName string, schema spec.Schema) string {
+	if schema.XML != nil && schema.XML.Attribute {
+		return xmlElementName(paramName, schema.XML) + ",attr"
+	}
+
+	isArray := schema.Items != nil && schema.Items.Schema != nil
+	if !isArray {
+		return xmlElementName(paramName, schema.XML)
+	}
+
+	// the items are named after the property, unless their own xml object names them
+	itemName := paramName
+	if schema.XML != nil && schema.XML.Name != "" {
+		itemName = schema.XML.Name
+	}
+	itemName = xmlElementName(itemName, schema.Items.Schema.XML)
+	if schema.XML == nil || !schema.XML.Wrapped {
+		return itemName
+	}
+
+	// the namespace goes in front of the whole path of the wrapped items
+	var ns string
+	if i := strings.LastIndex(itemName, " "); i >= 0 {
+		ns, itemName = itemName[:i+1], itemName[i+1:]
+	}
+	wrapper := paramName
+	if schema.XML.Name != "" {
+		wrapper = schema.XML.Name
 	}
+	return ns + wrapper + ">" + itemName
 }
 
 type genModelPropertySlice []genModelProperty
@@ -301,11 +449,12 @@ type genModelProperty struct {
 	XMLName               string             //`json:"xmlName,omitempty"`
 }
 
-func modelValidations(path, paramName, accessor, indexVar, valueExpression, pkg string, required bool, model spec.Schema) commonValidations {
-	tpe := typeForSchema(&model, pkg)
+func modelValidations(types *typeResolver, path, paramName, accessor, indexVar, valueExpression, pkg string, required bool, model spec.Schema) commonValidations {
+	tpe := types.typeForSchema(&model, pkg)
 
 	_, isPrimitive := primitives[tpe]
 	_, isCustomFormatter := customFormatters[tpe]
+	unmarshaller := types.unmarshallerFor(&model, pkg)
 
 	return commonValidations{
 		propertyDescriptor: propertyDescriptor{
@@ -318,6 +467,8 @@ func modelValidations(path, paramName, accessor, indexVar, valueExpression, pkg
 			IsPrimitive:       isPrimitive,
 			IsCustomFormatter: isCustomFormatter,
 			IsMap:             strings.HasPrefix(tpe, "map"),
+			IsPolymorphic:     unmarshaller != "",
+			Unmarshaller:      unmarshaller,
 		},
 		Required:         required,
 		Type:             tpe,
@@ -0,0 +1,46 @@
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelValidatesReferredDefinitions(t *testing.T) {
+	const doc = `{"swagger": "2.0", "info": {"title": "pets", "version": "1"}, "paths": {},
+		"definitions": {
+			"Pet": {"type": "object", "discriminator": "petType", "properties": {
+				"petType": {"type": "string"},
+				"owner": {"$ref": "#/definitions/Owner"},
+				"home": {"$ref": "#/definitions/Home"},
+				"friend": {"$ref": "#/definitions/Pet"}
+			}},
+			"Owner": {"type": "object", "required": ["firstName"], "properties": {"firstName": {"type": "string"}}},
+			"Home": {"type": "object", "properties": {"owner": {"$ref": "#/definitions/Owner"}}},
+			"Tag": {"type": "object", "properties": {"label": {"type": "string"}}}
+		}}`
+	specDoc, err := spec.New(json.RawMessage(doc), "")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	types, err := newTypeResolver(GenOpts{}, specDoc)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	validates := func(name string) map[string]bool {
+		result := make(map[string]bool)
+		for _, prop := range makeCodegenModel(types, name, "models", specDoc.Spec().Definitions[name], specDoc).Properties {
+			result[prop.ParamName] = prop.HasValidations
+		}
+		return result
+	}
+
+	// a property validates the definition it refers to, when that one has validations itself
+	assert.Equal(t, map[string]bool{"petType": false, "owner": true, "home": true, "friend": true}, validates("Pet"))
+	assert.Equal(t, map[string]bool{"owner": true}, validates("Home"))
+	assert.True(t, types.validatedDefinitions["Owner"])
+	assert.False(t, types.validatedDefinitions["Tag"])
+}
@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -17,25 +20,27 @@ import (
 var (
 	operationTemplate *template.Template
 	parameterTemplate *template.Template
+	responsesTemplate *template.Template
 )
 
-func init() {
-	bv, _ := Asset("templates/server/parameter.gotmpl")
-	parameterTemplate = template.Must(template.New("parameter").Parse(string(bv)))
-
-	bm, _ := Asset("templates/server/operation.gotmpl")
-	operationTemplate = template.Must(template.New("operation").Parse(string(bm)))
-}
-
 // GenerateServerOperation generates a parameter model, parameter validator, http handler implementations for a given operation
-// It also generates an operation handler interface that uses the parameter model for handling a valid request.
+// It also generates an operation handler interface that uses the parameter model for handling a valid request,
+// and a responder type for every response declared for the operation.
 // Allows for specifying a list of tags to include only certain tags for the generation
-func GenerateServerOperation(operationNames, tags []string, includeHandler, includeParameters bool, opts GenOpts) error {
+func GenerateServerOperation(operationNames, tags []string, includeHandler, includeParameters, includeResponses bool, opts GenOpts) error {
+	if err := configureGenerator(opts); err != nil {
+		return err
+	}
+
 	// Load the spec
 	specPath, specDoc, err := loadSpec(opts.Spec)
 	if err != nil {
 		return err
 	}
+	types, err := newTypeResolver(opts, specDoc)
+	if err != nil {
+		return err
+	}
 
 	if len(operationNames) == 0 {
 		operationNames = specDoc.OperationIDs()
@@ -54,12 +59,14 @@ func GenerateServerOperation(operationNames, tags []string, includeHandler, incl
 			ClientPackage:        opts.ClientPackage,
 			ServerPackage:        opts.ServerPackage,
 			Operation:            *operation,
+			Types:                types,
 			SecurityRequirements: specDoc.SecurityRequirementsFor(operation),
 			Principal:            opts.Principal,
 			Target:               filepath.Join(opts.Target, opts.APIPackage),
 			Tags:                 tags,
 			IncludeHandler:       includeHandler,
 			IncludeParameters:    includeParameters,
+			IncludeResponses:     includeResponses,
 			DumpData:             opts.DumpData,
 		}
 		if err := generator.Generate(); err != nil {
@@ -77,7 +84,8 @@ type operationGenerator struct {
 	ServerPackage        string
 	ClientPackage        string
 	Operation            spec.Operation
-	SecurityRequirements []spec.SecurityRequirement
+	Types                *typeResolver
+	SecurityRequirements [][]spec.SecurityRequirement
 	Principal            string
 	Target               string
 	Tags                 []string
@@ -86,6 +94,7 @@ type operationGenerator struct {
 	cname                string
 	IncludeHandler       bool
 	IncludeParameters    bool
+	IncludeResponses     bool
 	DumpData             bool
 }
 
@@ -97,19 +106,19 @@ func (o *operationGenerator) Generate() error {
 	authed := len(o.SecurityRequirements) > 0
 	for _, tag := range o.Operation.Tags {
 		if len(o.Tags) == 0 {
-			operations = append(operations, makeCodegenOperation(o.Name, tag, o.ModelsPackage, o.Principal, o.Target, o.Operation, authed))
+			operations = append(operations, makeCodegenOperation(o.Types, o.Name, tag, o.ModelsPackage, o.Principal, o.Target, o.Operation, authed))
 			continue
 		}
 		for _, ft := range o.Tags {
 			if ft == tag {
-				operations = append(operations, makeCodegenOperation(o.Name, tag, o.ModelsPackage, o.Principal, o.Target, o.Operation, authed))
+				operations = append(operations, makeCodegenOperation(o.Types, o.Name, tag, o.ModelsPackage, o.Principal, o.Target, o.Operation, authed))
 				break
 			}
 		}
 
 	}
 	if len(operations) == 0 {
-		operations = append(operations, makeCodegenOperation(o.Name, o.APIPackage, o.ModelsPackage, o.Principal, o.Target, o.Operation, authed))
+		operations = append(operations, makeCodegenOperation(o.Types, o.Name, o.APIPackage, o.ModelsPackage, o.Principal, o.Target, o.Operation, authed))
 	}
 
 	for _, op := range operations {
@@ -139,6 +148,13 @@ func (o *operationGenerator) Generate() error {
 		if len(o.Operation.Parameters) == 0 {
 			log.Println("no parameters for operation", op.Package+"."+op.ClassName)
 		}
+
+		if o.IncludeResponses && len(op.Responses) > 0 {
+			if err := o.generateResponses(); err != nil {
+				return fmt.Errorf("responses: %s", err)
+			}
+			log.Println("generated responses", op.Package+"."+op.ClassName+"Responses")
+		}
 	}
 
 	return nil
@@ -174,13 +190,28 @@ func (o *operationGenerator) generateParameterModel() error {
 	return writeToFile(fp, o.Name+"Parameters", buf.Bytes())
 }
 
-func makeCodegenOperation(name, pkg, modelsPkg, principal, target string, operation spec.Operation, authorized bool) genOperation {
+func (o *operationGenerator) generateResponses() error {
+	buf := bytes.NewBuffer(nil)
+
+	if err := responsesTemplate.Execute(buf, o.data); err != nil {
+		return err
+	}
+	log.Println("rendered responses template:", o.pkg+"."+o.cname+"Responses")
+
+	fp := filepath.Join(o.ServerPackage, o.Target)
+	if len(o.Operation.Tags) > 0 {
+		fp = filepath.Join(fp, o.pkg)
+	}
+	return writeToFile(fp, o.Name+"Responses", buf.Bytes())
+}
+
+func makeCodegenOperation(types *typeResolver, name, pkg, modelsPkg, principal, target string, operation spec.Operation, authorized bool) genOperation {
 	receiver := "o"
 
 	var params, qp, pp, hp, fp []genParameter
 	var hasQueryParams bool
 	for _, p := range operation.Parameters {
-		cp := makeCodegenParameter(receiver, modelsPkg, p)
+		cp := makeCodegenParameter(types, receiver, modelsPkg, p)
 		if cp.IsQueryParam {
 			hasQueryParams = true
 			qp = append(qp, cp)
@@ -197,16 +228,20 @@ func makeCodegenOperation(name, pkg, modelsPkg, principal, target string, operat
 		params = append(params, cp)
 	}
 
-	var successModel string
+	var successModel, successUnmarshaller, errorModel string
 	var returnsPrimitive, returnsFormatted, returnsContainer, returnsMap bool
 	if operation.Responses != nil {
 		if r, ok := operation.Responses.StatusCodeResponses[200]; ok {
-			tn := typeForSchema(r.Schema, modelsPkg)
+			tn := types.typeForSchema(r.Schema, modelsPkg)
 			_, returnsPrimitive = primitives[tn]
 			_, returnsFormatted = customFormatters[tn]
 			returnsContainer = r.Schema.Items != nil || r.Schema.Type.Contains("array")
 			returnsMap = strings.HasPrefix(tn, "map")
 			successModel = tn
+			successUnmarshaller = types.unmarshallerFor(r.Schema, modelsPkg)
+		}
+		if r := operation.Responses.Default; r != nil && r.Schema != nil {
+			errorModel = types.typeForSchema(r.Schema, modelsPkg)
 		}
 	}
 
@@ -233,6 +268,7 @@ func makeCodegenOperation(name, pkg, modelsPkg, principal, target string, operat
 			"github.com/go-swagger/go-swagger/httpkit/middleware",
 			"github.com/go-swagger/go-swagger/strfmt",
 		},
+		Imports:              types.customImports(),
 		Params:               params,
 		Summary:              operation.Summary,
 		QueryParams:          qp,
@@ -242,16 +278,119 @@ func makeCodegenOperation(name, pkg, modelsPkg, principal, target string, operat
 		HasQueryParams:       hasQueryParams,
 		SuccessModel:         successModel,
 		SuccessZero:          zero,
+		SuccessIsPolymorphic: successUnmarshaller != "",
+		SuccessUnmarshaller:  successUnmarshaller,
+		ErrorModel:           errorModel,
 		ReturnsPrimitive:     returnsPrimitive,
 		ReturnsFormatted:     returnsFormatted,
 		ReturnsContainer:     returnsContainer,
 		ReturnsMap:           returnsMap,
-		ReturnsComplexObject: !returnsPrimitive && !returnsFormatted && !returnsContainer && !returnsMap,
+		ReturnsComplexObject: !returnsPrimitive && !returnsFormatted && !returnsContainer && !returnsMap && successUnmarshaller == "",
+		Responses:            makeCodegenResponses(types, swag.ToGoName(name), receiver, modelsPkg, operation.Responses),
 		Authorized:           authorized,
 		Principal:            prin,
 	}
 }
 
+func makeCodegenResponses(types *typeResolver, operationName, receiver, modelsPkg string, responses *spec.Responses) []genResponse {
+	if responses == nil {
+		return nil
+	}
+
+	var codes []int
+	for code := range responses.StatusCodeResponses {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+
+	var result []genResponse
+	for _, code := range codes {
+		name := swag.ToGoName(http.StatusText(code))
+		if name == "" {
+			name = "Status" + strconv.Itoa(code)
+		}
+		result = append(result, makeCodegenResponse(types, operationName+name, receiver, modelsPkg, code, responses.StatusCodeResponses[code]))
+	}
+	if responses.Default != nil {
+		result = append(result, makeCodegenResponse(types, operationName+"Default", receiver, modelsPkg, 0, *responses.Default))
+	}
+	return result
+}
+
+func makeCodegenResponse(types *typeResolver, name, receiver, modelsPkg string, code int, response spec.Response) genResponse {
+	var headers []genHeader
+	for hn, header := range response.Headers {
+		headers = append(headers, makeCodegenHeader(types, receiver, hn, header))
+	}
+	sort.Sort(genHeaderSlice(headers))
+
+	var payload string
+	var payloadIsPointer, payloadIsNilable bool
+	if response.Schema != nil {
+		payload = types.typeForSchema(response.Schema, modelsPkg)
+		_, isPrimitive := primitives[payload]
+		_, isFormatted := customFormatters[payload]
+		isContainer := strings.HasPrefix(payload, "[]") || strings.HasPrefix(payload, "map")
+		// values of a definition with a discriminator are interfaces already
+		_, isPolymorphic := types.polymorphicBase(response.Schema)
+		payloadIsPointer = !isPrimitive && !isFormatted && !isContainer && !isPolymorphic && payload != "interface{}"
+		payloadIsNilable = payloadIsPointer || isContainer || isPolymorphic || payload == "interface{}" || payload == "strfmt.Base64"
+	}
+
+	return genResponse{
+		ClassName:        name,
+		Name:             swag.ToJSONName(name),
+		Description:      response.Description,
+		Code:             code,
+		IsDefault:        code == 0,
+		ReceiverName:     receiver,
+		Headers:          headers,
+		Payload:          payload,
+		PayloadIsPointer: payloadIsPointer,
+		PayloadIsNilable: payloadIsNilable,
+	}
+}
+
+func makeCodegenHeader(types *typeResolver, receiver, name string, header spec.Header) genHeader {
+	propertyName := swag.ToGoName(name)
+	tpe := types.resolveSimpleType(header.Type, header.Format, header.Items)
+
+	result := genHeader{
+		PropertyName:     propertyName,
+		VarName:          swag.ToJSONName(name),
+		Name:             name,
+		Description:      header.Description,
+		GoType:           tpe,
+		IsArray:          header.Type == "array",
+		CollectionFormat: header.CollectionFormat,
+		Formatter:        stringFormatter(tpe, receiver+"."+propertyName),
+	}
+	if result.IsArray {
+		result.ItemsFormatter = stringFormatter(strings.TrimPrefix(tpe, "[]"), "v")
+	}
+	return result
+}
+
+// stringFormatter builds the expression to turn a value of the go type into a string
+func stringFormatter(tpe, valueExpression string) string {
+	if tpe == "string" {
+		return valueExpression
+	}
+	if formatter, ok := stringFormatters[tpe]; ok {
+		return formatter + "(" + valueExpression + ")"
+	}
+	if tpe == "strfmt.Duration" {
+		return "time.Duration(" + valueExpression + ").String()"
+	}
+	if tpe == "strfmt.Base64" {
+		return "base64.StdEncoding.EncodeToString(" + valueExpression + ")"
+	}
+	if _, ok := customFormatters[tpe]; ok {
+		return "string(" + valueExpression + ")"
+	}
+	return valueExpression + ".String()"
+}
+
 func operationDocString(name string, operation spec.Operation) string {
 	hdr := fmt.Sprintf("%s %s", name, operation.Description)
 	ed := operation.ExternalDocs
@@ -279,6 +418,8 @@ type genOperation struct {
 	ClassName      string //`json:"classname,omitempty"`      // -
 	Name           string //`json:"name,omitempty"`           // -
 	HumanClassName string //`json:"humanClassname,omitempty"` // -
+	Method         string //`json:"method,omitempty"`         // -
+	Path           string //`json:"path,omitempty"`           // -
 
 	Summary      string //`json:"summary,omitempty"`
 	Description  string //`json:"description,omitempty"` // -
@@ -293,11 +434,16 @@ type genOperation struct {
 
 	SuccessModel         string //`json:"successModel,omitempty"`         // -
 	SuccessZero          string //`json:"successZero,omitempty"`         // -
+	SuccessIsPolymorphic bool   //`json:"successIsPolymorphic,omitempty"` // an interface that is unmarshalled by its discriminator
+	SuccessUnmarshaller  string //`json:"successUnmarshaller,omitempty"`  // -
 	ReturnsPrimitive     bool   //`json:"returnsPrimitive,omitempty"`     // -
 	ReturnsFormatted     bool   //`json:"returnsFormatted,omitempty"`     // -
 	ReturnsContainer     bool   //`json:"returnsContainer,omitempty"`     // -
 	ReturnsComplexObject bool   //`json:"returnsComplexObject,omitempty"` // -
 	ReturnsMap           bool   //`json:"returnsMap,omitempty"`
+	ErrorModel           string //`json:"errorModel,omitempty"`           // -
+
+	Responses []genResponse //`json:"responses,omitempty"` // -
 
 	Params         []genParameter //`json:"params,omitempty"`         // -
 	QueryParams    []genParameter //`json:"queryParams,omitempty"`    // -
@@ -309,12 +455,46 @@ type genOperation struct {
 	HasFileParams  bool           //`json:"hasFileParams,omitempty"`  // -
 }
 
-func makeCodegenParameter(receiver, modelsPkg string, param spec.Parameter) genParameter {
+type genResponse struct {
+	ClassName    string
+	Name         string
+	Description  string
+	Code         int
+	IsDefault    bool
+	ReceiverName string
+
+	Headers []genHeader
+
+	Payload          string
+	PayloadIsPointer bool
+	PayloadIsNilable bool
+}
+
+type genHeaderSlice []genHeader
+
+func (s genHeaderSlice) Len() int           { return len(s) }
+func (s genHeaderSlice) Less(i, j int) bool { return s[i].Name < s[j].Name }
+func (s genHeaderSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type genHeader struct {
+	PropertyName     string
+	VarName          string
+	Name             string
+	Description      string
+	GoType           string
+	IsArray          bool
+	CollectionFormat string
+	Formatter        string
+	ItemsFormatter   string
+}
+
+func makeCodegenParameter(types *typeResolver, receiver, modelsPkg string, param spec.Parameter) genParameter {
 	var ctx sharedParam
 	var child *genParameterItem
 
 	if param.In == "body" {
 		ctx = makeGenValidations(modelValidations(
+			types,
 			"\""+swag.ToJSONName(param.Name)+"\"",
 			swag.ToJSONName(param.Name),
 			swag.ToGoName(param.Name),
@@ -325,7 +505,7 @@ func makeCodegenParameter(receiver, modelsPkg string, param spec.Parameter) genP
 			*param.Schema))
 
 	} else {
-		ctx = makeGenValidations(paramValidations(receiver, param))
+		ctx = makeGenValidations(paramValidations(types, receiver, param))
 		thisItem := genParameterItem{}
 		thisItem.sharedParam = ctx
 		thisItem.ValueExpression = ctx.IndexVar + "c"
@@ -335,6 +515,7 @@ func makeCodegenParameter(receiver, modelsPkg string, param spec.Parameter) genP
 
 		if param.Items != nil {
 			it := makeCodegenParamItem(
+				types,
 				"fmt.Sprintf(\"%s.%v\", "+ctx.Path+", "+ctx.IndexVar+")",
 				ctx.ParamName,
 				ctx.PropertyName,
@@ -348,8 +529,14 @@ func makeCodegenParameter(receiver, modelsPkg string, param spec.Parameter) genP
 
 	}
 
+	// optional values that are valid when they're zero can't tell whether they're set,
+	// so the client parameters have a pointer for them
+	isNullable := !param.Required && param.In != "body" && param.Type != "file" &&
+		!ctx.IsContainer && ctx.Type != "string"
+
 	return genParameter{
 		sharedParam:      ctx,
+		Name:             param.Name,
 		Description:      param.Description,
 		ReceiverName:     receiver,
 		IsQueryParam:     param.In == "query",
@@ -358,6 +545,8 @@ func makeCodegenParameter(receiver, modelsPkg string, param spec.Parameter) genP
 		IsPathParam:      param.In == "path",
 		IsFormParam:      param.In == "formData",
 		IsFileParam:      param.Type == "file",
+		IsCustomType:     types.isCustomType(ctx.Type),
+		IsNullable:       isNullable,
 		CollectionFormat: param.CollectionFormat,
 		Child:            child,
 		Location:         param.In,
@@ -367,6 +556,7 @@ func makeCodegenParameter(receiver, modelsPkg string, param spec.Parameter) genP
 
 type genParameter struct {
 	sharedParam
+	Name             string            //`json:"name,omitempty"`
 	ReceiverName     string            //`json:"receiverName,omitempty"`
 	Description      string            //`json:"description,omitempty"`
 	IsQueryParam     bool              //`json:"isQueryParam,omitempty"`
@@ -375,6 +565,8 @@ type genParameter struct {
 	IsHeaderParam    bool              //`json:"isHeaderParam,omitempty"`
 	IsBodyParam      bool              //`json:"isBodyParam,omitempty"`
 	IsFileParam      bool              //`json:"isFileParam,omitempty"`
+	IsCustomType     bool              //`json:"isCustomType,omitempty"` // mapped to a go type from the generator options
+	IsNullable       bool              //`json:"isNullable,omitempty"`   // optional and valid when zero, a pointer in the client parameters
 	CollectionFormat string            //`json:"collectionFormat,omitempty"`
 	Child            *genParameterItem //`json:"child,omitempty"`
 	BodyParam        *genParameter     //`json:"bodyParam,omitempty"`
@@ -383,8 +575,8 @@ type genParameter struct {
 	Location         string            //`json:"location,omitempty"`
 }
 
-func makeCodegenParamItem(path, paramName, accessor, indexVar, valueExpression string, parent genParameterItem, items spec.Items) genParameterItem {
-	ctx := makeGenValidations(paramItemValidations(path, paramName, accessor, indexVar, valueExpression, items))
+func makeCodegenParamItem(types *typeResolver, path, paramName, accessor, indexVar, valueExpression string, parent genParameterItem, items spec.Items) genParameterItem {
+	ctx := makeGenValidations(paramItemValidations(types, path, paramName, accessor, indexVar, valueExpression, items))
 
 	res := genParameterItem{}
 	res.sharedParam = ctx
@@ -397,6 +589,7 @@ func makeCodegenParamItem(path, paramName, accessor, indexVar, valueExpression s
 	var child *genParameterItem
 	if items.Items != nil {
 		it := makeCodegenParamItem(
+			types,
 			"fmt.Sprintf(\"%s.%v\", "+ctx.Path+", "+ctx.IndexVar+")",
 			ctx.ParamName,
 			ctx.PropertyName,
@@ -426,8 +619,8 @@ type sharedParam struct {
 	propertyDescriptor
 }
 
-func paramItemValidations(path, paramName, accessor, indexVar, valueExpression string, items spec.Items) commonValidations {
-	tpe := resolveSimpleType(items.Type, items.Format, items.Items)
+func paramItemValidations(types *typeResolver, path, paramName, accessor, indexVar, valueExpression string, items spec.Items) commonValidations {
+	tpe := types.resolveSimpleType(items.Type, items.Format, items.Items)
 	_, isPrimitive := primitives[tpe]
 	_, isCustomFormatter := customFormatters[tpe]
 
@@ -463,11 +656,11 @@ func paramItemValidations(path, paramName, accessor, indexVar, valueExpression s
 	}
 }
 
-func paramValidations(receiver string, param spec.Parameter) commonValidations {
+func paramValidations(types *typeResolver, receiver string, param spec.Parameter) commonValidations {
 	accessor := swag.ToGoName(param.Name)
 	paramName := swag.ToJSONName(param.Name)
 
-	tpe := typeForParameter(param)
+	tpe := types.typeForParameter(param)
 	_, isPrimitive := primitives[tpe]
 	_, isCustomFormatter := customFormatters[tpe]
 
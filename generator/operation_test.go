@@ -0,0 +1,23 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullableParameters(t *testing.T) {
+	nullable := func(param *spec.Parameter) bool {
+		return makeCodegenParameter(new(typeResolver), "o", "models", *param).IsNullable
+	}
+
+	assert.True(t, nullable(spec.QueryParam("limit").Typed("integer", "int32")))
+	assert.True(t, nullable(spec.QueryParam("verbose").Typed("boolean", "")))
+	assert.True(t, nullable(spec.QueryParam("since").Typed("string", "date-time")))
+
+	assert.False(t, nullable(spec.QueryParam("limit").Typed("integer", "int32").AsRequired()))
+	assert.False(t, nullable(spec.QueryParam("q").Typed("string", "")))
+	assert.False(t, nullable(spec.PathParam("id").Typed("integer", "int64")))
+	assert.False(t, nullable(spec.BodyParam("pet", spec.RefProperty("#/definitions/Pet"))))
+}
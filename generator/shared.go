@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"text/template"
 
 	"github.com/go-swagger/go-swagger/spec"
 	"github.com/go-swagger/go-swagger/swag"
@@ -50,9 +51,22 @@ type GenOpts struct {
 	ClientPackage string
 	Principal     string
 	Target        string
-	TypeMapping   map[string]string
-	Imports       map[string]string
-	DumpData      bool
+	// TypeMapping maps formats and definition names to go types, like money to github.com/acme/money.Amount.
+	// The models for mapped definitions aren't generated.
+	TypeMapping map[string]string
+	// Imports are the import paths for the package aliases used in the type mapping
+	Imports  map[string]string
+	DumpData bool
+	// TemplateDir has templates that replace the compiled in templates with the same file name,
+	// like model.gotmpl or server/builder.gotmpl, and shared partials in its partials directory
+	TemplateDir string
+	// TemplateFuncs are extra functions for the templates
+	TemplateFuncs template.FuncMap
+}
+
+// configureGenerator sets up the templates for the options
+func configureGenerator(opts GenOpts) error {
+	return compileTemplates(opts)
 }
 
 type generatorOptions struct {
@@ -70,6 +84,8 @@ type propertyDescriptor struct {
 	IsCustomFormatter bool   //`json:"isCustomFormatter,omitempty"` // custom format or default format
 	IsContainer       bool   //`json:"isContainer,omitempty"`       // slice
 	IsMap             bool   // json:"isMap,omitempty"
+	IsPolymorphic     bool   //`json:"isPolymorphic,omitempty"` // unmarshalled by a discriminator
+	Unmarshaller      string //`json:"unmarshaller,omitempty"`
 }
 
 type commonValidations struct {
@@ -127,6 +143,7 @@ func loadSpec(specFile string) (string, *spec.Document, error) {
 	if err != nil {
 		return "", nil, err
 	}
+	hoistAnonymousSchemas(specDoc)
 	return specPath, specDoc, nil
 }
 
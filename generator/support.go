@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"mime"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 	"text/template"
 
+	"github.com/go-swagger/go-swagger/httpkit"
 	"github.com/go-swagger/go-swagger/spec"
 	"github.com/go-swagger/go-swagger/swag"
 )
@@ -21,24 +23,21 @@ var (
 	configureAPITemplate *template.Template
 )
 
-func init() {
-	bv, _ := Asset("templates/server/builder.gotmpl")
-	builderTemplate = template.Must(template.New("builder").Parse(string(bv)))
-
-	bm, _ := Asset("templates/server/main.gotmpl")
-	mainTemplate = template.Must(template.New("main").Parse(string(bm)))
-
-	bc, _ := Asset("templates/server/configureapi.gotmpl")
-	configureAPITemplate = template.Must(template.New("configureapi").Parse(string(bc)))
-}
-
 // GenerateSupport generates the supporting files for an API
 func GenerateSupport(name string, modelNames, operationIDs []string, includeUI bool, opts GenOpts) error {
+	if err := configureGenerator(opts); err != nil {
+		return err
+	}
+
 	// Load the spec
 	_, specDoc, err := loadSpec(opts.Spec)
 	if err != nil {
 		return err
 	}
+	types, err := newTypeResolver(opts, specDoc)
+	if err != nil {
+		return err
+	}
 
 	models, mnc := make(map[string]spec.Schema), len(modelNames)
 	for k, v := range specDoc.Spec().Definitions {
@@ -79,6 +78,7 @@ func GenerateSupport(name string, modelNames, operationIDs []string, includeUI b
 	generator := appGenerator{
 		Name:       name,
 		SpecDoc:    specDoc,
+		Types:      types,
 		Models:     models,
 		Operations: operations,
 		Target:     opts.Target,
@@ -99,6 +99,7 @@ func GenerateSupport(name string, modelNames, operationIDs []string, includeUI b
 type appGenerator struct {
 	Name          string
 	SpecDoc       *spec.Document
+	Types         *typeResolver
 	Package       string
 	APIPackage    string
 	ModelsPackage string
@@ -197,29 +198,56 @@ func (a *appGenerator) generateAPIBuilder(app *genApp) error {
 }
 
 var mediaTypeNames = map[string]string{
-	"application/json":        "json",
-	"application/x-yaml":      "yaml",
-	"application/x-protobuf":  "protobuf",
-	"application/x-capnproto": "capnproto",
-	"application/x-thrift":    "thrift",
-	"application/xml":         "xml",
-	"text/xml":                "xml",
-	"text/x-markdown":         "markdown",
-	"text/html":               "html",
-	"text/csv":                "csv",
-	"text/tsv":                "tsv",
-	"text/javascript":         "js",
-	"text/css":                "css",
+	"application/json":         "json",
+	"application/x-yaml":       "yaml",
+	"application/x-protobuf":   "protobuf",
+	"application/x-capnproto":  "capnproto",
+	"application/x-thrift":     "thrift",
+	"application/xml":          "xml",
+	"application/octet-stream": "byteStream",
+	"text/plain":               "text",
+	"text/xml":                 "xml",
+	"text/x-markdown":          "markdown",
+	"text/html":                "html",
+	"text/csv":                 "csv",
+	"text/tsv":                 "tsv",
+	"text/javascript":          "js",
+	"text/css":                 "css",
+}
+
+// mediaTypeName gets the serializer name for a media type, ignoring its parameters.
+// Vendor media types like application/vnd.acme.v2+json use the serializer of their structured syntax suffix.
+func mediaTypeName(mediaType string) (string, bool) {
+	mt := mediaType
+	if parsed, _, err := mime.ParseMediaType(mediaType); err == nil {
+		mt = parsed
+	}
+	if nm, ok := mediaTypeNames[mt]; ok {
+		return nm, true
+	}
+	if base, ok := httpkit.SuffixMediaType(mt); ok {
+		nm, ok := mediaTypeNames[base]
+		return nm, ok
+	}
+	return "", false
 }
 
 var knownProducers = map[string]string{
-	"json": "swagger.JSONProducer",
-	"yaml": "swagger.YAMLProducer",
+	"json":       "httpkit.JSONProducer",
+	"yaml":       "httpkit.YAMLProducer",
+	"xml":        "httpkit.XMLProducer",
+	"text":       "httpkit.TextProducer",
+	"csv":        "httpkit.CSVProducer",
+	"byteStream": "httpkit.ByteStreamProducer",
 }
 
 var knownConsumers = map[string]string{
-	"json": "swagger.JSONConsumer",
-	"yaml": "swagger.YAMLConsumer",
+	"json":       "httpkit.JSONConsumer",
+	"yaml":       "httpkit.YAMLConsumer",
+	"xml":        "httpkit.XMLConsumer",
+	"text":       "httpkit.TextConsumer",
+	"csv":        "httpkit.CSVConsumer",
+	"byteStream": "httpkit.ByteStreamConsumer",
 }
 
 func getSerializer(sers []genSerGroup, ext string) (*genSerGroup, bool) {
@@ -245,7 +273,7 @@ func (a *appGenerator) makeCodegenApp() genApp {
 	consumesJSON := false
 	var consumes []genSerGroup
 	for _, cons := range a.SpecDoc.RequiredConsumes() {
-		cn, ok := mediaTypeNames[cons]
+		cn, ok := mediaTypeName(cons)
 		if !ok {
 			continue
 		}
@@ -292,7 +320,7 @@ func (a *appGenerator) makeCodegenApp() genApp {
 	producesJSON := false
 	var produces []genSerGroup
 	for _, prod := range a.SpecDoc.RequiredProduces() {
-		pn, ok := mediaTypeNames[prod]
+		pn, ok := mediaTypeName(prod)
 		if !ok {
 			continue
 		}
@@ -337,15 +365,16 @@ func (a *appGenerator) makeCodegenApp() genApp {
 	var security []genSecurityScheme
 	for _, scheme := range a.SpecDoc.RequiredSchemes() {
 		if req, ok := a.SpecDoc.Spec().SecurityDefinitions[scheme]; ok {
-			if req.Type == "basic" || req.Type == "apiKey" {
+			if req.Type == "basic" || req.Type == "apiKey" || req.Type == "oauth2" {
 				security = append(security, genSecurityScheme{
 					AppName:        appName,
 					ReceiverName:   receiver,
-					ClassName:      swag.ToGoName(req.Name),
-					HumanClassName: swag.ToHumanNameLower(req.Name),
-					Name:           swag.ToJSONName(req.Name),
+					ClassName:      swag.ToGoName(scheme),
+					HumanClassName: swag.ToHumanNameLower(scheme),
+					Name:           scheme,
 					IsBasicAuth:    strings.ToLower(req.Type) == "basic",
 					IsAPIKeyAuth:   strings.ToLower(req.Type) == "apikey",
+					IsOAuth2:       strings.ToLower(req.Type) == "oauth2",
 					Principal:      a.Principal,
 					Source:         req.In,
 				})
@@ -358,6 +387,7 @@ func (a *appGenerator) makeCodegenApp() genApp {
 	defaultImports = append(defaultImports, importPath)
 	for mn, m := range a.Models {
 		mod := *makeCodegenModel(
+			a.Types,
 			mn,
 			a.ModelsPackage,
 			m,
@@ -378,12 +408,12 @@ func (a *appGenerator) makeCodegenApp() genApp {
 		if len(o.Tags) > 0 {
 			for _, tag := range o.Tags {
 				tns[tag] = struct{}{}
-				op := makeCodegenOperation(on, tag, a.ModelsPackage, a.Principal, a.Target, o, authed)
+				op := makeCodegenOperation(a.Types, on, tag, a.ModelsPackage, a.Principal, a.Target, o, authed)
 				op.ReceiverName = receiver
 				genOps = append(genOps, op)
 			}
 		} else {
-			op := makeCodegenOperation(on, ap, a.ModelsPackage, a.Principal, a.Target, o, authed)
+			op := makeCodegenOperation(a.Types, on, ap, a.ModelsPackage, a.Principal, a.Target, o, authed)
 			op.ReceiverName = receiver
 			genOps = append(genOps, op)
 		}
@@ -420,6 +450,7 @@ func (a *appGenerator) makeCodegenApp() genApp {
 		DefaultConsumes:     defaultConsumes,
 		DefaultProduces:     defaultProduces,
 		DefaultImports:      defaultImports,
+		Imports:             a.Types.customImports(),
 		SecurityDefinitions: security,
 		Models:              genMods,
 		Operations:          genOps,
@@ -447,6 +478,7 @@ type genApp struct {
 	SecurityDefinitions []genSecurityScheme
 	Models              []genModel
 	Operations          []genOperation
+	OperationGroups     []genOperationGroup
 	IncludeUI           bool
 	SwaggerJSON         string
 }
@@ -480,6 +512,7 @@ type genSecurityScheme struct {
 	ReceiverName   string
 	IsBasicAuth    bool
 	IsAPIKeyAuth   bool
+	IsOAuth2       bool
 	Source         string
 	Principal      string
 }
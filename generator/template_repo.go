@@ -0,0 +1,158 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/go-swagger/go-swagger/swag"
+)
+
+// generatorTemplates are the templates the generator renders,
+// with the files they're loaded from relative to the templates directory
+var generatorTemplates = []struct {
+	target **template.Template
+	name   string
+	file   string
+}{
+	{&modelTemplate, "model", "model.gotmpl"},
+	{&modelValidatorTemplate, "modelvalidator", "modelvalidator.gotmpl"},
+	{&parameterTemplate, "parameter", "server/parameter.gotmpl"},
+	{&operationTemplate, "operation", "server/operation.gotmpl"},
+	{&responsesTemplate, "responses", "server/responses.gotmpl"},
+	{&builderTemplate, "builder", "server/builder.gotmpl"},
+	{&mainTemplate, "main", "server/main.gotmpl"},
+	{&configureAPITemplate, "configureapi", "server/configureapi.gotmpl"},
+	{&clientFacadeTemplate, "facade", "client/facade.gotmpl"},
+	{&clientTemplate, "client", "client/client.gotmpl"},
+	{&clientParameterTemplate, "clientparameter", "client/parameter.gotmpl"},
+}
+
+// partialsDir is the directory below the template directory with the shared partials,
+// every template can use the templates defined in them
+const partialsDir = "partials"
+
+// defaultTemplateFuncs are the functions available to every template
+func defaultTemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"pascalize": swag.ToGoName,
+		"camelize":  swag.ToJSONName,
+		"humanize":  swag.ToHumanNameLower,
+		"snakize":   swag.ToFileName,
+		"dasherize": swag.ToCommandName,
+		"lower":     strings.ToLower,
+		"upper":     strings.ToUpper,
+		"join":      strings.Join,
+		"comment":   commentedLines,
+		"json": func(data interface{}) (string, error) {
+			b, err := json.Marshal(data)
+			return string(b), err
+		},
+	}
+}
+
+func init() {
+	if err := compileTemplates(GenOpts{}); err != nil {
+		panic(err)
+	}
+}
+
+// compileTemplates compiles the templates of the generator.
+// A template in the template dir of the options replaces the compiled in template with the same file name,
+// the partials in that dir and the template funcs of the options are added to every template.
+// A partial can also replace a block a template defines, like the modelproperty block of model.gotmpl.
+func compileTemplates(opts GenOpts) error {
+	funcs := defaultTemplateFuncs()
+	for k, v := range opts.TemplateFuncs {
+		funcs[k] = v
+	}
+
+	partials, err := loadPartials(opts.TemplateDir)
+	if err != nil {
+		return err
+	}
+
+	for _, gt := range generatorTemplates {
+		src, err := loadTemplate(opts.TemplateDir, gt.file)
+		if err != nil {
+			return err
+		}
+
+		tpl, err := template.New(gt.name).Funcs(funcs).Parse(src)
+		if err != nil {
+			return fmt.Errorf("template %s: %v", gt.file, err)
+		}
+		// the partials are parsed last, so the blocks they define replace the ones of the template
+		for _, partial := range partials {
+			if _, err := tpl.New(partial.name).Parse(partial.content); err != nil {
+				return fmt.Errorf("partial %s: %v", partial.name, err)
+			}
+		}
+		*gt.target = tpl
+	}
+	return nil
+}
+
+// loadTemplate reads a template from the template dir, or from the compiled in templates when it's not in there
+func loadTemplate(templateDir, file string) (string, error) {
+	if templateDir != "" {
+		b, err := ioutil.ReadFile(filepath.Join(templateDir, filepath.FromSlash(file)))
+		if err == nil {
+			return string(b), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+
+	b, err := Asset("templates/" + file)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+type templatePartial struct {
+	name    string
+	content string
+}
+
+// loadPartials reads the .gotmpl files in the partials dir of the template dir,
+// a partial is named after its path in the partials dir without the extension, like "handlers/logging"
+func loadPartials(templateDir string) ([]templatePartial, error) {
+	if templateDir == "" {
+		return nil, nil
+	}
+	root := filepath.Join(templateDir, partialsDir)
+	if _, err := os.Stat(root); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	var partials []templatePartial
+	err := filepath.Walk(root, func(pth string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(pth) != ".gotmpl" {
+			return nil
+		}
+		b, err := ioutil.ReadFile(pth)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, pth)
+		if err != nil {
+			return err
+		}
+		partials = append(partials, templatePartial{
+			name:    strings.TrimSuffix(filepath.ToSlash(rel), ".gotmpl"),
+			content: string(b),
+		})
+		return nil
+	})
+	return partials, err
+}
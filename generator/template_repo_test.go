@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTemplateFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "templates")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	for name, content := range files {
+		pth := filepath.Join(dir, filepath.FromSlash(name))
+		if !assert.NoError(t, os.MkdirAll(filepath.Dir(pth), 0755)) {
+			t.FailNow()
+		}
+		if !assert.NoError(t, ioutil.WriteFile(pth, []byte(content), 0644)) {
+			t.FailNow()
+		}
+	}
+	return dir
+}
+
+func renderTemplate(t *testing.T, tpl *template.Template, data interface{}) string {
+	buf := bytes.NewBuffer(nil)
+	if !assert.NoError(t, tpl.Execute(buf, data)) {
+		t.FailNow()
+	}
+	return buf.String()
+}
+
+func TestCompileTemplatesOverride(t *testing.T) {
+	dir := writeTemplateFiles(t, map[string]string{
+		"server/main.gotmpl":               `main {{shout .}} {{template "handlers/logging" .}}`,
+		"partials/handlers/logging.gotmpl": `logging {{.}}`,
+		"partials/property.gotmpl":         `{{define "modelproperty"}}{{.PropertyName}} {{.DataType}}{{end}}`,
+		"partials/notes.txt":               `{{ not a template`,
+	})
+	defer os.RemoveAll(dir)
+	defer compileTemplates(GenOpts{})
+
+	err := compileTemplates(GenOpts{
+		TemplateDir:   dir,
+		TemplateFuncs: template.FuncMap{"shout": strings.ToUpper},
+	})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// the template in the dir replaces the compiled in one and gets the partials and the extra funcs
+	assert.Equal(t, "main API logging api", renderTemplate(t, mainTemplate, "api"))
+
+	// the others are compiled in, a partial replaces the block the template defines
+	pet := map[string]interface{}{
+		"Package":    "models",
+		"ClassName":  "Pet",
+		"StructName": "Pet",
+		"Properties": []map[string]interface{}{{"PropertyName": "Name", "ParamName": "name", "DataType": "string"}},
+	}
+	model := renderTemplate(t, modelTemplate, pet)
+	assert.Contains(t, model, "\nName string\n")
+	assert.NotContains(t, model, "`json:")
+
+	// without a template dir the compiled in templates are back
+	assert.NoError(t, compileTemplates(GenOpts{}))
+	assert.Contains(t, renderTemplate(t, modelTemplate, pet), "Name string `json:\"name\"")
+}
+
+func TestCompileTemplatesErrors(t *testing.T) {
+	defer compileTemplates(GenOpts{})
+
+	dir := writeTemplateFiles(t, map[string]string{"model.gotmpl": `{{if}}`})
+	defer os.RemoveAll(dir)
+	err := compileTemplates(GenOpts{TemplateDir: dir})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "template model.gotmpl")
+	}
+
+	partialDir := writeTemplateFiles(t, map[string]string{"partials/broken.gotmpl": `{{end}}`})
+	defer os.RemoveAll(partialDir)
+	err = compileTemplates(GenOpts{TemplateDir: partialDir})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "partial broken")
+	}
+
+	// a function the templates don't know about fails too
+	funcDir := writeTemplateFiles(t, map[string]string{"model.gotmpl": `{{shout .}}`})
+	defer os.RemoveAll(funcDir)
+	assert.Error(t, compileTemplates(GenOpts{TemplateDir: funcDir}))
+}
@@ -1,20 +1,14 @@
 package generator
 
 import (
-	"path/filepath"
+	"fmt"
+	"path"
 	"strings"
 
 	"github.com/go-swagger/go-swagger/spec"
 	"github.com/go-swagger/go-swagger/swag"
 )
 
-func typeForSchemaOrArray(schemas *spec.SchemaOrArray, modelsPkg string) string {
-	if schemas == nil || len(schemas.Schemas) > 0 {
-		return "interface{}"
-	}
-	return typeForSchema(schemas.Schema, modelsPkg)
-}
-
 var goImports = map[string]string{
 	"inf.Dec":   "speter.net/go/exp/math/dec/inf",
 	"big.Int":   "math/big",
@@ -70,6 +64,20 @@ var stringConverters = map[string]string{
 	"float64": "swag.ConvertFloat64",
 }
 
+var stringFormatters = map[string]string{
+	"int8":    "swag.FormatInt8",
+	"int16":   "swag.FormatInt16",
+	"int32":   "swag.FormatInt32",
+	"int64":   "swag.FormatInt64",
+	"uint8":   "swag.FormatUint8",
+	"uint16":  "swag.FormatUint16",
+	"uint32":  "swag.FormatUint32",
+	"uint64":  "swag.FormatUint64",
+	"bool":    "swag.FormatBool",
+	"float32": "swag.FormatFloat32",
+	"float64": "swag.FormatFloat64",
+}
+
 // typeMapping contais a mapping of format or type name to go type
 var typeMapping = map[string]string{
 	"byte":       "strfmt.Base64",
@@ -121,11 +129,135 @@ func init() {
 	}
 }
 
-func typeForParameter(param spec.Parameter) string {
-	return resolveSimpleType(param.Type, param.Format, param.Items)
+// customType is a go type a format or a definition is mapped to in the generator options
+type customType struct {
+	GoType     string
+	Alias      string
+	ImportPath string
 }
 
-func resolveSimpleType(tn, fmt string, items *spec.Items) string {
+// typeResolver resolves the go types for the schemas of the spec a generator runs for.
+// It has the custom types from the options and the hierarchies of the definitions with a discriminator,
+// every run gets a resolver of its own.
+type typeResolver struct {
+	// customFormats are the custom types by format, without the dashes like the keys of the type mapping
+	customFormats map[string]customType
+	// customDefinitions are the custom types by definition name
+	customDefinitions map[string]customType
+	// discriminatedTypes are the definitions that take part in a hierarchy, by definition name
+	discriminatedTypes map[string]discriminatedType
+	// validatedDefinitions are the definitions whose models validate something, by definition name
+	validatedDefinitions map[string]bool
+}
+
+// newTypeResolver makes the resolver for the type mapping and the imports of the options and the loaded spec
+func newTypeResolver(opts GenOpts, specDoc *spec.Document) (*typeResolver, error) {
+	customs, err := parseTypeMapping(opts.TypeMapping, opts.Imports)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := &typeResolver{
+		customFormats:      make(map[string]customType, len(customs)),
+		customDefinitions:  customs,
+		discriminatedTypes: discriminatedTypesOf(specDoc.Spec()),
+	}
+	for name, ct := range customs {
+		// a format mapped with its dashes doesn't replace the one mapped without them
+		if key := formatKey(name); key == name || !resolver.hasCustomFormat(key) {
+			resolver.customFormats[key] = ct
+		}
+	}
+	resolver.validatedDefinitions = resolver.validatedDefinitionsOf(specDoc)
+	return resolver, nil
+}
+
+// parseTypeMapping gets the custom types for the type mapping by format or definition name.
+// The go type is either qualified with an alias from the imports, like money.Amount for the import money,
+// or it has the whole import path, like github.com/acme/money.Amount.
+func parseTypeMapping(typeMapping, imports map[string]string) (map[string]customType, error) {
+	result := make(map[string]customType, len(typeMapping))
+	for name, goType := range typeMapping {
+		ct := customType{GoType: goType}
+		if i := strings.LastIndex(goType, "."); i > strings.LastIndex(goType, "/") {
+			pkg, tn := goType[:i], goType[i+1:]
+			if strings.Contains(pkg, "/") {
+				ct.ImportPath = pkg
+				ct.Alias = strings.NewReplacer("-", "_", ".", "_").Replace(path.Base(pkg))
+			} else {
+				importPath, ok := imports[pkg]
+				if !ok {
+					return nil, fmt.Errorf("type mapping for %s: no import for %s", name, pkg)
+				}
+				ct.ImportPath = importPath
+				ct.Alias = pkg
+			}
+			ct.GoType = ct.Alias + "." + tn
+		}
+		result[name] = ct
+	}
+	return result, nil
+}
+
+// formatKey normalizes formats the same way as the keys of the type mapping
+func formatKey(format string) string {
+	return strings.Replace(format, "-", "", -1)
+}
+
+func (t *typeResolver) hasCustomFormat(key string) bool {
+	_, ok := t.customFormats[key]
+	return ok
+}
+
+func (t *typeResolver) customFormat(format string) (customType, bool) {
+	if format == "" {
+		return customType{}, false
+	}
+	ct, ok := t.customFormats[formatKey(format)]
+	return ct, ok
+}
+
+func (t *typeResolver) customDefinition(name string) (customType, bool) {
+	ct, ok := t.customDefinitions[name]
+	return ct, ok
+}
+
+func (t *typeResolver) isCustomType(goType string) bool {
+	goType = strings.TrimLeft(goType, "[]")
+	for _, ct := range t.customDefinitions {
+		if ct.GoType == goType {
+			return true
+		}
+	}
+	return false
+}
+
+const strfmtImportPath = "github.com/go-swagger/go-swagger/strfmt"
+
+// customImports gets the imports for the custom types by alias,
+// the imports that end up unused in a generated file are removed when it's formatted
+func (t *typeResolver) customImports() map[string]string {
+	if len(t.customDefinitions) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(t.customDefinitions))
+	for _, ct := range t.customDefinitions {
+		// the templates import strfmt already
+		if ct.ImportPath != "" && !(ct.Alias == "strfmt" && ct.ImportPath == strfmtImportPath) {
+			result[ct.Alias] = ct.ImportPath
+		}
+	}
+	return result
+}
+
+func (t *typeResolver) typeForParameter(param spec.Parameter) string {
+	return t.resolveSimpleType(param.Type, param.Format, param.Items)
+}
+
+func (t *typeResolver) resolveSimpleType(tn, fmt string, items *spec.Items) string {
+	if ct, ok := t.customFormat(fmt); ok {
+		return ct.GoType
+	}
 	if fmt != "" {
 		if tpe, ok := typeMapping[strings.Replace(fmt, "-", "", -1)]; ok {
 			return tpe
@@ -139,29 +271,42 @@ func resolveSimpleType(tn, fmt string, items *spec.Items) string {
 		if items == nil {
 			return "[]interface{}"
 		}
-		return "[]" + resolveSimpleType(items.Type, items.Format, items.Items)
+		return "[]" + t.resolveSimpleType(items.Type, items.Format, items.Items)
 	}
 	return tn
 }
 
-func typeForSchema(schema *spec.Schema, modelsPkg string) string {
+func (t *typeResolver) typeForSchemaOrArray(schemas *spec.SchemaOrArray, modelsPkg string) string {
+	if schemas == nil || len(schemas.Schemas) > 0 {
+		return "interface{}"
+	}
+	return t.typeForSchema(schemas.Schema, modelsPkg)
+}
+
+func (t *typeResolver) typeForSchema(schema *spec.Schema, modelsPkg string) string {
 	if schema == nil {
 		return "interface{}"
 	}
-	if schema.Ref.GetURL() != nil {
-		tn := swag.ToGoName(filepath.Base(schema.Ref.GetURL().Fragment))
+	if name := refName(schema); name != "" {
+		if ct, ok := t.customDefinition(name); ok {
+			return ct.GoType
+		}
+		tn := swag.ToGoName(name)
 		if modelsPkg != "" {
 			return modelsPkg + "." + tn
 		}
 		return tn
 	}
+	if ct, ok := t.customFormat(schema.Format); ok {
+		return ct.GoType
+	}
 	if schema.Format != "" {
 		if tpe, ok := typeMapping[strings.Replace(schema.Format, "-", "", -1)]; ok {
 			return tpe
 		}
 	}
 	if schema.Type.Contains("array") {
-		return "[]" + typeForSchemaOrArray(schema.Items, modelsPkg)
+		return "[]" + t.typeForSchemaOrArray(schema.Items, modelsPkg)
 	}
 	if schema.Type.Contains("file") {
 		return typeMapping["file"]
@@ -179,7 +324,7 @@ func typeForSchema(schema *spec.Schema, modelsPkg string) string {
 		return "string"
 	}
 	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
-		return "map[string]" + typeForSchema(schema.AdditionalProperties.Schema, modelsPkg)
+		return "map[string]" + t.typeForSchema(schema.AdditionalProperties.Schema, modelsPkg)
 	}
 	if schema.Type.Contains("object") || schema.Type.Contains("") || len(schema.Type) == 0 {
 		return "map[string]interface{}"
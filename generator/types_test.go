@@ -0,0 +1,88 @@
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestResolver(t *testing.T, doc string, opts GenOpts) *typeResolver {
+	specDoc, err := spec.New(json.RawMessage(doc), "")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	types, err := newTypeResolver(opts, specDoc)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return types
+}
+
+func TestTypeResolverCustomTypes(t *testing.T) {
+	types := newTestResolver(t, `{"swagger": "2.0", "info": {"title": "types", "version": "1"}, "paths": {}}`, GenOpts{
+		TypeMapping: map[string]string{
+			"my-type": "github.com/acme/types.Dashed",
+			"mytype":  "github.com/acme/types.Plain",
+		},
+	})
+
+	// definitions are mapped by their exact name
+	ct, ok := types.customDefinition("my-type")
+	assert.True(t, ok)
+	assert.Equal(t, "types.Dashed", ct.GoType)
+	ct, ok = types.customDefinition("mytype")
+	assert.True(t, ok)
+	assert.Equal(t, "types.Plain", ct.GoType)
+	_, ok = types.customDefinition("MyType")
+	assert.False(t, ok)
+
+	// formats ignore the dashes, the mapping without them wins
+	ct, ok = types.customFormat("my-type")
+	assert.True(t, ok)
+	assert.Equal(t, "types.Plain", ct.GoType)
+
+	assert.Equal(t, "types.Dashed", types.typeForSchema(spec.RefProperty("#/definitions/my-type"), "models"))
+	assert.Equal(t, "types.Plain", types.typeForSchema(spec.RefProperty("#/definitions/mytype"), "models"))
+	assert.Equal(t, "models.Other", types.typeForSchema(spec.RefProperty("#/definitions/other"), "models"))
+	assert.Equal(t, map[string]string{"types": "github.com/acme/types"}, types.customImports())
+}
+
+func TestTypeResolverPerRun(t *testing.T) {
+	const doc = `{"swagger": "2.0", "info": {"title": "pets", "version": "1"}, "paths": {},
+		"definitions": {"Pet": {"type": "object", "discriminator": "petType", "properties": {"petType": {"type": "string"}}}}}`
+	withMapping := newTestResolver(t, doc, GenOpts{TypeMapping: map[string]string{"Pet": "github.com/acme/pets.Pet"}})
+	plain := newTestResolver(t, `{"swagger": "2.0", "info": {"title": "none", "version": "1"}, "paths": {}}`, GenOpts{})
+
+	// a resolver doesn't see the custom types or the hierarchies of another run
+	assert.Equal(t, "pets.Pet", withMapping.typeForSchema(spec.RefProperty("#/definitions/Pet"), ""))
+	assert.Equal(t, "Pet", plain.typeForSchema(spec.RefProperty("#/definitions/Pet"), ""))
+	assert.Equal(t, "BasePet", withMapping.structNameFor("Pet"))
+	assert.Equal(t, "Pet", plain.structNameFor("Pet"))
+}
+
+func TestParseTypeMapping(t *testing.T) {
+	customs, err := parseTypeMapping(map[string]string{
+		"money":    "money.Amount",
+		"uuid":     "github.com/acme/go-ids.ID",
+		"duration": "time.Duration",
+		"any":      "interface{}",
+	}, map[string]string{"money": "github.com/acme/money", "time": "time"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, customType{GoType: "money.Amount", Alias: "money", ImportPath: "github.com/acme/money"}, customs["money"])
+		// the alias for an import path comes from its last element
+		assert.Equal(t, customType{GoType: "go_ids.ID", Alias: "go_ids", ImportPath: "github.com/acme/go-ids"}, customs["uuid"])
+		assert.Equal(t, customType{GoType: "time.Duration", Alias: "time", ImportPath: "time"}, customs["duration"])
+		// a type without a package needs no import
+		assert.Equal(t, customType{GoType: "interface{}"}, customs["any"])
+	}
+
+	_, err = parseTypeMapping(map[string]string{"money": "money.Amount"}, nil)
+	if assert.Error(t, err) {
+		assert.Equal(t, "type mapping for money: no import for money", err.Error())
+	}
+
+	_, err = newTypeResolver(GenOpts{TypeMapping: map[string]string{"money": "money.Amount"}}, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,66 @@
+package httpkit
+
+import (
+	"encoding"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// ByteStreamConsumer creates a consumer for binary data.
+// An io.Writer gets the body copied into it without buffering it,
+// byte slices and binary unmarshalers get the whole body.
+func ByteStreamConsumer() Consumer {
+	return ConsumerFunc(func(reader io.Reader, data interface{}) error {
+		if w, ok := data.(io.Writer); ok {
+			_, err := io.Copy(w, reader)
+			return err
+		}
+
+		b, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return err
+		}
+		switch v := data.(type) {
+		case *[]byte:
+			*v = b
+		case *interface{}:
+			*v = b
+		case encoding.BinaryUnmarshaler:
+			return v.UnmarshalBinary(b)
+		default:
+			return fmt.Errorf("%T is not supported by the byte stream consumer", data)
+		}
+		return nil
+	})
+}
+
+// ByteStreamProducer creates a producer for binary data.
+// An io.Reader is piped to the response without buffering it, and closed when it's an io.Closer.
+// Byte slices, strings and binary marshalers are written as they are.
+func ByteStreamProducer() Producer {
+	return ProducerFunc(func(writer io.Writer, data interface{}) error {
+		switch v := data.(type) {
+		case io.Reader:
+			if c, ok := v.(io.Closer); ok {
+				defer c.Close()
+			}
+			_, err := io.Copy(writer, v)
+			return err
+		case []byte:
+			_, err := writer.Write(v)
+			return err
+		case string:
+			_, err := io.WriteString(writer, v)
+			return err
+		case encoding.BinaryMarshaler:
+			b, err := v.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			_, err = writer.Write(b)
+			return err
+		}
+		return fmt.Errorf("%T is not supported by the byte stream producer", data)
+	})
+}
@@ -0,0 +1,68 @@
+package httpkit
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var consProdBytes = []byte{0x00, 0x01, 0x02, 0xff}
+
+type closeRecorder struct {
+	*bytes.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestByteStreamConsumer(t *testing.T) {
+	cons := ByteStreamConsumer()
+
+	var buf bytes.Buffer
+	err := cons.Consume(bytes.NewReader(consProdBytes), &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdBytes, buf.Bytes())
+
+	var b []byte
+	err = cons.Consume(bytes.NewReader(consProdBytes), &b)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdBytes, b)
+
+	var untyped interface{}
+	err = cons.Consume(bytes.NewReader(consProdBytes), &untyped)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdBytes, untyped)
+
+	var str string
+	assert.Error(t, cons.Consume(bytes.NewReader(consProdBytes), &str))
+}
+
+func TestByteStreamProducer(t *testing.T) {
+	prod := ByteStreamProducer()
+
+	reader := &closeRecorder{Reader: bytes.NewReader(consProdBytes)}
+	rw := httptest.NewRecorder()
+	err := prod.Produce(rw, reader)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdBytes, rw.Body.Bytes())
+	assert.True(t, reader.closed)
+
+	rw = httptest.NewRecorder()
+	err = prod.Produce(rw, ioutil.NopCloser(bytes.NewReader(consProdBytes)))
+	assert.NoError(t, err)
+	assert.Equal(t, consProdBytes, rw.Body.Bytes())
+
+	rw = httptest.NewRecorder()
+	err = prod.Produce(rw, consProdBytes)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdBytes, rw.Body.Bytes())
+
+	rw = httptest.NewRecorder()
+	assert.Error(t, prod.Produce(rw, 1))
+}
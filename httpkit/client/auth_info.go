@@ -0,0 +1,62 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-swagger/go-swagger/errors"
+)
+
+// ClientAuthInfoWriterFunc converts a function to a request writer interface
+type ClientAuthInfoWriterFunc func(*http.Request) error
+
+// AuthenticateRequest adds authentication data to the request
+func (fn ClientAuthInfoWriterFunc) AuthenticateRequest(req *http.Request) error {
+	return fn(req)
+}
+
+// ClientAuthInfoWriter implementations know how to write authentication info to a request,
+// they are registered on the runtime by the name of the security definition they satisfy
+type ClientAuthInfoWriter interface {
+	AuthenticateRequest(*http.Request) error
+}
+
+// BasicAuth provides a basic auth info writer
+func BasicAuth(username, password string) ClientAuthInfoWriter {
+	return ClientAuthInfoWriterFunc(func(r *http.Request) error {
+		r.SetBasicAuth(username, password)
+		return nil
+	})
+}
+
+// APIKeyAuth provides an API key auth info writer.
+// The key is written to either a header or the query string
+func APIKeyAuth(name, in, value string) ClientAuthInfoWriter {
+	inl := strings.ToLower(in)
+	if inl != "query" && inl != "header" {
+		// panic because this is most likely a typo
+		panic(errors.New(500, "api key auth: in value needs to be either \"query\" or \"header\"."))
+	}
+
+	if inl == "query" {
+		return ClientAuthInfoWriterFunc(func(r *http.Request) error {
+			q := r.URL.Query()
+			q.Set(name, value)
+			r.URL.RawQuery = q.Encode()
+			return nil
+		})
+	}
+
+	return ClientAuthInfoWriterFunc(func(r *http.Request) error {
+		r.Header.Set(name, value)
+		return nil
+	})
+}
+
+// BearerToken provides a header based oauth2 bearer access token auth info writer
+func BearerToken(token string) ClientAuthInfoWriter {
+	return ClientAuthInfoWriterFunc(func(r *http.Request) error {
+		r.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBasicAuth(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/", nil)
+	assert.NoError(t, BasicAuth("someone", "with a password").AuthenticateRequest(r))
+
+	usr, pw, ok := r.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "someone", usr)
+	assert.Equal(t, "with a password", pw)
+}
+
+func TestAPIKeyAuth(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/?limit=10", nil)
+	assert.NoError(t, APIKeyAuth("api_key", "query", "the-token").AuthenticateRequest(r))
+	assert.Equal(t, "the-token", r.URL.Query().Get("api_key"))
+	assert.Equal(t, "10", r.URL.Query().Get("limit"))
+
+	r, _ = http.NewRequest("GET", "/", nil)
+	assert.NoError(t, APIKeyAuth("X-API-KEY", "header", "the-token").AuthenticateRequest(r))
+	assert.Equal(t, "the-token", r.Header.Get("X-API-KEY"))
+
+	assert.Panics(t, func() { APIKeyAuth("api_key", "qery", "the-token") })
+}
+
+func TestBearerToken(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/", nil)
+	assert.NoError(t, BearerToken("the-token").AuthenticateRequest(r))
+	assert.Equal(t, "Bearer the-token", r.Header.Get("Authorization"))
+}
+
+const securedSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "secured", "version": "1.0.0"},
+  "basePath": "/api",
+  "securityDefinitions": {
+    "basic": {"type": "basic"},
+    "apiKey": {"type": "apiKey", "name": "X-API-KEY", "in": "header"}
+  },
+  "paths": {
+    "/tasks": {
+      "get": {
+        "operationId": "listTasks",
+        "security": [{"apiKey": []}],
+        "responses": {"200": {"description": "ok"}}
+      }
+    }
+  }
+}`
+
+func TestRuntimeAuthInfoWriters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "the-token", r.Header.Get("X-API-KEY"))
+		_, _, ok := r.BasicAuth()
+		assert.False(t, ok)
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	doc, err := spec.New(json.RawMessage(securedSpec), "")
+	if assert.NoError(t, err) {
+		rt := newRuntime(server)
+		rt.Spec = doc
+		rt.AuthInfoWriters["basic"] = BasicAuth("someone", "with a password")
+		rt.AuthInfoWriters["apiKey"] = APIKeyAuth("X-API-KEY", "header", "the-token")
+
+		err := rt.Submit(&Request{Method: "GET", Path: "/tasks"}, nil)
+		assert.NoError(t, err)
+	}
+}
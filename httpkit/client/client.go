@@ -4,12 +4,30 @@ package client
 
 import (
 	"bytes"
+	"encoding"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 
+	"github.com/go-swagger/go-swagger/errors"
 	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/internal/validate"
 	"github.com/go-swagger/go-swagger/spec"
 	"github.com/go-swagger/go-swagger/strfmt"
+	"github.com/go-swagger/go-swagger/swag"
+)
+
+const (
+	formURLEncodedMime = "application/x-www-form-urlencoded"
+	multipartFormMime  = "multipart/form-data"
 )
 
 // Runtime represents an API client that uses the transport
@@ -18,23 +36,45 @@ type Runtime struct {
 	DefaultMediaType string
 	Consumers        map[string]httpkit.Consumer
 	Producers        map[string]httpkit.Producer
-	Transport        http.Transport
+	Transport        http.RoundTripper
 	Spec             *spec.Document
 	Host             string
 	BasePath         string
-	client           *http.Client
+	Schemes          []string
 	Formats          strfmt.Registry
+
+	// AuthInfoWriters contains the auth info writers by security definition name,
+	// the writers for the security definitions of an operation are applied to its requests
+	AuthInfoWriters map[string]ClientAuthInfoWriter
 }
 
-// New creates a new default runtim for a swagger api client.
+// New creates a new default runtime for a swagger api client.
+// The host, base path and schemes are initialized from the spec when it has them.
 func New(swaggerSpec *spec.Document) *Runtime {
 	var rt Runtime
-	rt.DefaultMediaType = "application/json"
+	rt.Spec = swaggerSpec
+	rt.DefaultMediaType = httpkit.JSONMime
 	rt.Consumers = map[string]httpkit.Consumer{
-		"application/json": httpkit.JSONConsumer(),
+		httpkit.JSONMime: httpkit.JSONConsumer(),
+		httpkit.XMLMime:  httpkit.XMLConsumer(),
 	}
 	rt.Producers = map[string]httpkit.Producer{
-		"application/json": httpkit.JSONProducer(),
+		httpkit.JSONMime: httpkit.JSONProducer(),
+		httpkit.XMLMime:  httpkit.XMLProducer(),
+	}
+	rt.AuthInfoWriters = make(map[string]ClientAuthInfoWriter)
+	rt.Transport = http.DefaultTransport
+	rt.Formats = strfmt.Default
+	rt.Host = "localhost"
+	rt.BasePath = "/"
+	if swaggerSpec != nil {
+		if swaggerSpec.Host() != "" {
+			rt.Host = swaggerSpec.Host()
+		}
+		if swaggerSpec.BasePath() != "" {
+			rt.BasePath = swaggerSpec.BasePath()
+		}
+		rt.Schemes = swaggerSpec.Spec().Schemes
 	}
 	return &rt
 }
@@ -45,8 +85,17 @@ type Request struct {
 	Method    string
 	Operation *spec.Operation
 	Params    interface{}
+
+	// ErrorModel when set is used to read the body of an error response into,
+	// it ends up as the value of the returned APIError
+	ErrorModel interface{}
 }
 
+// ResponseReaderFunc reads the body of a successful response with the consumer for its media type.
+// When it's passed as the result to Submit it's called instead of consuming the body into the result,
+// this way a value of a definition with a discriminator is read into the definition it tells.
+type ResponseReaderFunc func(io.Reader, httpkit.Consumer) error
+
 // APIError wraps an error model and captures the status code
 type APIError struct {
 	OperationName string
@@ -58,73 +107,166 @@ func (a *APIError) Error() string {
 	return fmt.Sprintf("%s (status %d): %+v ", a.OperationName, a.Code, a.Value)
 }
 
-func validateRequest(request *Request) error {
-	// TODO: validate the request here against the operation
-	// this should only be the params model against the schema for the operation
+// paramsFor gets the parameters of the request, when the operation is in the spec
+// these include the ones of its path and the ones it refers to, like the server binds them
+func (r *Runtime) paramsFor(request *Request) []spec.Parameter {
+	if r.Spec == nil {
+		return request.Operation.Parameters
+	}
+	if _, ok := r.Spec.OperationFor(request.Method, request.Path); !ok {
+		return request.Operation.Parameters
+	}
+
+	params := r.Spec.ParamsFor(request.Method, request.Path)
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	result := make([]spec.Parameter, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, params[k])
+	}
+	return result
+}
+
+// validateRequest validates the params of the request against the parameter definitions
+// of the operation, so that invalid requests fail before they are sent
+func (r *Runtime) validateRequest(request *Request) error {
+	params, _ := request.Params.(map[string]interface{})
+
+	var root interface{}
+	if r.Spec != nil {
+		root = r.Spec.Spec()
+	}
 
+	var result []error
+	for _, param := range r.paramsFor(request) {
+		param := param
+		value, ok := params[param.Name]
+		if !ok || isEmptyValue(value) {
+			if param.Required && param.Default == nil {
+				result = append(result, errors.Required(param.Name, param.In))
+			}
+			continue
+		}
+
+		var validator validate.EntityValidator
+		switch {
+		case param.In == "body":
+			// without a spec document there is nothing to resolve a $ref against
+			if param.Schema == nil || (root == nil && param.Schema.Ref.String() != "") {
+				continue
+			}
+			validator = validate.NewSchemaValidator(param.Schema, root, param.Name, r.Formats)
+		case param.Type == "file":
+			continue
+		default:
+			validator = validate.NewParamValidator(&param, r.Formats)
+		}
+
+		if rr := validator.Validate(value); rr != nil && rr.HasErrors() {
+			result = append(result, rr.AsError())
+		}
+	}
+
+	if len(result) > 0 {
+		return errors.CompositeValidationError(result...)
+	}
 	return nil
 }
 
+// escapePathValue escapes a value for a path segment, spaces become %20 instead of +
+func escapePathValue(value string) string {
+	return strings.Replace(url.QueryEscape(value), "+", "%20", -1)
+}
+
+func isEmptyValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
+
 // Submit a request and when there is a body on success it will turn that into the result
 // all other things are turned into an api error for swagger which retains the status code
 func (r *Runtime) Submit(request *Request, result interface{}) error {
-	if err := validateRequest(request); err != nil {
-		return err
+	if request.Operation == nil && r.Spec != nil {
+		if op, ok := r.Spec.OperationFor(request.Method, request.Path); ok {
+			request.Operation = op
+		}
+	}
+	if request.Operation == nil {
+		request.Operation = new(spec.Operation)
 	}
 
-	p := request.Params.(map[string]interface{})
-
-	// TODO: Work out if there is a file involved and handle that
-	// if a file is involved only form/multipart requests will be made
-	consumerMediaType := r.DefaultMediaType
-	producerMediaType := r.DefaultMediaType
+	if err := r.validateRequest(request); err != nil {
+		return err
+	}
 
-	var body *bytes.Buffer
-	if pet, ok := p["pet"]; ok {
-		body = bytes.NewBuffer(nil)
-		prod := r.Producers[producerMediaType]
-		if err := prod.Produce(body, pet); err != nil {
-			return err
-		}
+	req, consumerMediaType, err := r.buildRequest(request)
+	if err != nil {
+		return err
 	}
 
-	req, _ := http.NewRequest(request.Method, request.Path, body)
-	req.Header.Add(httpkit.HeaderContentType, producerMediaType) // use selected producer mime type
-	req.Header.Add(httpkit.HeaderAccept, consumerMediaType)      // use selected consumer mime type
-	if body != nil && body.Len() > 0 {
-		req.Header.Add("Content-Length", fmt.Sprintf("%d", body.Len()))
+	if err := r.authenticateRequest(request.Operation, req); err != nil {
+		return err
 	}
 
-	res, err := r.client.Do(req) // make requests, by default follows 10 redirects before failing
+	client := &http.Client{Transport: r.Transport}
+	res, err := client.Do(req) // make requests, by default follows 10 redirects before failing
 	if err != nil {
 		return err
 	}
+	defer res.Body.Close()
+
+	if res.Header.Get(httpkit.HeaderContentType) != "" {
+		mt, _, err := httpkit.ContentType(res.Header)
+		if err != nil {
+			return err
+		}
+		consumerMediaType = mt
+	}
 
 	sc := res.StatusCode / 100 // read the response
 	switch sc {
 	case 2:
-		if res.StatusCode == 200 { // only 200 should parse the response body in the result
-			cons, ok := r.Consumers[consumerMediaType]
-			if ok {
-				if err := cons.Consume(res.Body, result); err != nil {
-					return err
-				}
-			} else {
-				return &APIError{
-					OperationName: request.Operation.ID,
-					Value:         fmt.Sprintf("no consumer for %q", consumerMediaType),
-					Code:          res.StatusCode,
-				}
+		// a 204 or an empty body can't be read into the result
+		if result == nil || res.StatusCode == http.StatusNoContent || res.ContentLength == 0 {
+			return nil
+		}
+		cons, ok := r.Consumers[consumerMediaType]
+		if !ok {
+			return &APIError{
+				OperationName: request.Operation.ID,
+				Value:         fmt.Sprintf("no consumer for %q", consumerMediaType),
+				Code:          res.StatusCode,
 			}
 		}
-		return nil
+		if reader, ok := result.(ResponseReaderFunc); ok {
+			return reader(res.Body, cons)
+		}
+		return cons.Consume(res.Body, result)
 
 	case 4, 5:
 		// this is an error, check for default model and use that
 		cons, ok := r.Consumers[consumerMediaType]
 		if ok {
 			var eres interface{}
-			if err := cons.Consume(res.Body, &eres); err != nil {
+			target := request.ErrorModel
+			if target == nil {
+				target = &eres
+			} else {
+				eres = target
+			}
+			if err := cons.Consume(res.Body, target); err != nil {
 				return &APIError{OperationName: request.Operation.ID, Value: err, Code: res.StatusCode}
 			}
 			return &APIError{OperationName: request.Operation.ID, Value: eres, Code: res.StatusCode}
@@ -134,3 +276,224 @@ func (r *Runtime) Submit(request *Request, result interface{}) error {
 
 	return nil
 }
+
+// buildRequest turns the params of the request into a http request for the operation,
+// it also returns the media type that was negotiated for reading the response
+func (r *Runtime) buildRequest(request *Request) (*http.Request, string, error) {
+	operation := request.Operation
+	params, _ := request.Params.(map[string]interface{})
+
+	// the values of path parameters are escaped after the path is joined, so they can't change the request target
+	pth := path.Join("/", r.BasePath, request.Path)
+	query := make(url.Values)
+	header := make(http.Header)
+	form := make(url.Values)
+	files := make(map[string]interface{})
+	var body interface{}
+
+	for _, param := range r.paramsFor(request) {
+		value, ok := params[param.Name]
+		if !ok || isEmptyValue(value) {
+			continue
+		}
+
+		switch param.In {
+		case "path":
+			values := stringValues(value, param.CollectionFormat)
+			for i, v := range values {
+				values[i] = escapePathValue(v)
+			}
+			pth = strings.Replace(pth, "{"+param.Name+"}", strings.Join(values, ","), -1)
+		case "query":
+			for _, v := range stringValues(value, param.CollectionFormat) {
+				query.Add(param.Name, v)
+			}
+		case "header":
+			for _, v := range stringValues(value, param.CollectionFormat) {
+				header.Add(param.Name, v)
+			}
+		case "formData":
+			if param.Type == "file" {
+				files[param.Name] = value
+				continue
+			}
+			for _, v := range stringValues(value, param.CollectionFormat) {
+				form.Add(param.Name, v)
+			}
+		case "body":
+			body = value
+		}
+	}
+
+	consumes, produces := operation.Consumes, operation.Produces
+	if r.Spec != nil {
+		if len(consumes) == 0 {
+			consumes = r.Spec.Spec().Consumes
+		}
+		if len(produces) == 0 {
+			produces = r.Spec.Spec().Produces
+		}
+	}
+
+	var buf *bytes.Buffer
+	var contentType string
+	switch {
+	case len(files) > 0 || (len(form) > 0 && swag.ContainsStringsCI(consumes, multipartFormMime)):
+		buf = bytes.NewBuffer(nil)
+		mw := multipart.NewWriter(buf)
+		for k, vs := range form {
+			for _, v := range vs {
+				if err := mw.WriteField(k, v); err != nil {
+					return nil, "", err
+				}
+			}
+		}
+		for k, v := range files {
+			if err := writeFile(mw, k, v); err != nil {
+				return nil, "", err
+			}
+		}
+		if err := mw.Close(); err != nil {
+			return nil, "", err
+		}
+		contentType = mw.FormDataContentType()
+
+	case len(form) > 0:
+		buf = bytes.NewBufferString(form.Encode())
+		contentType = formURLEncodedMime
+
+	case body != nil:
+		contentType = r.pickMediaType(consumes, func(mt string) bool { _, ok := r.Producers[mt]; return ok })
+		prod, ok := r.Producers[contentType]
+		if !ok {
+			return nil, "", fmt.Errorf("no producer for %q", contentType)
+		}
+		buf = bytes.NewBuffer(nil)
+		if err := prod.Produce(buf, body); err != nil {
+			return nil, "", err
+		}
+	}
+
+	// the path is escaped already, so it goes in the opaque part to keep url.URL from escaping it again
+	u := url.URL{
+		Scheme:   r.pickScheme(operation.Schemes),
+		Opaque:   "//" + r.Host + pth,
+		RawQuery: query.Encode(),
+	}
+
+	var req *http.Request
+	var err error
+	if buf != nil {
+		req, err = http.NewRequest(request.Method, u.String(), buf)
+	} else {
+		req, err = http.NewRequest(request.Method, u.String(), nil)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	for k, vs := range header {
+		req.Header[k] = vs
+	}
+	if contentType != "" {
+		req.Header.Set(httpkit.HeaderContentType, contentType) // use selected producer mime type
+	}
+	consumerMediaType := r.pickMediaType(produces, func(mt string) bool { _, ok := r.Consumers[mt]; return ok })
+	req.Header.Set(httpkit.HeaderAccept, consumerMediaType) // use selected consumer mime type
+
+	return req, consumerMediaType, nil
+}
+
+// authenticateRequest applies the auth info writers for the security definitions of the operation
+func (r *Runtime) authenticateRequest(operation *spec.Operation, req *http.Request) error {
+	if r.Spec == nil {
+		return nil
+	}
+	for name := range r.Spec.SecurityDefinitionsFor(operation) {
+		if writer, ok := r.AuthInfoWriters[name]; ok {
+			if err := writer.AuthenticateRequest(req); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pickMediaType returns the first media type that is supported,
+// when none are supported it falls back to the default media type
+func (r *Runtime) pickMediaType(mediaTypes []string, supported func(string) bool) string {
+	for _, mt := range mediaTypes {
+		if supported(mt) {
+			return mt
+		}
+	}
+	return r.DefaultMediaType
+}
+
+// pickScheme prefers https when it's allowed for the operation,
+// when no schemes are known it uses http
+func (r *Runtime) pickScheme(schemes []string) string {
+	if len(schemes) == 0 {
+		schemes = r.Schemes
+	}
+	if len(schemes) == 0 {
+		return "http"
+	}
+	if swag.ContainsStringsCI(schemes, "https") {
+		return "https"
+	}
+	return strings.ToLower(schemes[0])
+}
+
+// stringValues converts a param value into the strings to put on the wire,
+// slices are joined with the collection format
+func stringValues(value interface{}, collectionFormat string) []string {
+	if tm, ok := value.(encoding.TextMarshaler); ok {
+		b, err := tm.MarshalText()
+		if err == nil {
+			return []string{string(b)}
+		}
+	}
+	if s, ok := value.(fmt.Stringer); ok {
+		return []string{s.String()}
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() != reflect.Uint8 {
+		var values []string
+		for i := 0; i < rv.Len(); i++ {
+			values = append(values, stringValues(rv.Index(i).Interface(), "")...)
+		}
+		return swag.JoinByFormat(values, collectionFormat)
+	}
+	return []string{fmt.Sprintf("%v", value)}
+}
+
+// writeFile adds a file to the multipart form, it knows about httpkit.File, *os.File and io.Reader
+func writeFile(mw *multipart.Writer, name string, value interface{}) error {
+	var fileName string
+	var rdr io.Reader
+
+	switch f := value.(type) {
+	case httpkit.File:
+		return writeFile(mw, name, &f)
+	case *httpkit.File:
+		fileName, rdr = name, f.Data
+		if f.Header != nil {
+			fileName = f.Header.Filename
+		}
+	case *os.File:
+		fileName, rdr = filepath.Base(f.Name()), f
+	case io.Reader:
+		fileName, rdr = name, f
+	default:
+		return fmt.Errorf("%s: unsupported file value %T", name, value)
+	}
+
+	fw, err := mw.CreateFormFile(name, fileName)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fw, rdr)
+	return err
+}
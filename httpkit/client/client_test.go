@@ -0,0 +1,363 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/errors"
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+type task struct {
+	ID      int64  `json:"id"`
+	Content string `json:"content"`
+}
+
+func newRuntime(server *httptest.Server) *Runtime {
+	rt := New(nil)
+	u, _ := url.Parse(server.URL)
+	rt.Host = u.Host
+	rt.BasePath = "/api"
+	return rt
+}
+
+func TestRuntimeSubmitPathQueryHeaderBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/api/tasks/12", r.URL.Path)
+		assert.Equal(t, "a|b", r.URL.Query().Get("tags"))
+		assert.Equal(t, []string{"1", "2"}, r.URL.Query()["ids"])
+		assert.Equal(t, "trace-id", r.Header.Get("X-Request-Id"))
+		assert.Equal(t, httpkit.JSONMime, r.Header.Get(httpkit.HeaderContentType))
+		assert.Equal(t, httpkit.JSONMime, r.Header.Get(httpkit.HeaderAccept))
+
+		var body task
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "do the dishes", body.Content)
+
+		body.ID = 12
+		rw.Header().Set(httpkit.HeaderContentType, httpkit.JSONMime)
+		rw.WriteHeader(http.StatusOK)
+		json.NewEncoder(rw).Encode(body)
+	}))
+	defer server.Close()
+
+	op := new(spec.Operation)
+	op.ID = "updateTask"
+	op.Parameters = []spec.Parameter{
+		*spec.PathParam("id").Typed("integer", "int64"),
+		*spec.QueryParam("tags").CollectionOf(spec.NewItems().Typed("string", ""), "pipes"),
+		*spec.QueryParam("ids").CollectionOf(spec.NewItems().Typed("integer", "int64"), "multi"),
+		*spec.HeaderParam("X-Request-Id").Typed("string", ""),
+		*spec.BodyParam("body", new(spec.Schema)),
+	}
+
+	var result task
+	err := newRuntime(server).Submit(&Request{
+		Method:    "PUT",
+		Path:      "/tasks/{id}",
+		Operation: op,
+		Params: map[string]interface{}{
+			"id":           int64(12),
+			"tags":         []string{"a", "b"},
+			"ids":          []int64{1, 2},
+			"X-Request-Id": "trace-id",
+			"body":         task{Content: "do the dishes"},
+		},
+	}, &result)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(12), result.ID)
+		assert.Equal(t, "do the dishes", result.Content)
+	}
+}
+
+func TestRuntimeSubmitEscapesPathParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/files/a%2Fb%20c%3Fd%23e/versions", r.URL.EscapedPath())
+		assert.Equal(t, "/api/files/a/b c?d#e/versions", r.URL.Path)
+		assert.Equal(t, "", r.URL.RawQuery)
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	op := new(spec.Operation)
+	op.ID = "listVersions"
+	op.Parameters = []spec.Parameter{*spec.PathParam("name").Typed("string", "")}
+
+	err := newRuntime(server).Submit(&Request{
+		Method:    "GET",
+		Path:      "/files/{name}/versions",
+		Operation: op,
+		Params:    map[string]interface{}{"name": "a/b c?d#e"},
+	}, nil)
+	assert.NoError(t, err)
+}
+
+func TestRuntimeSubmitResponseReader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set(httpkit.HeaderContentType, httpkit.XMLMime)
+		rw.WriteHeader(http.StatusOK)
+		rw.Write([]byte(`<task><id>1</id><content>do the dishes</content></task>`))
+	}))
+	defer server.Close()
+
+	op := new(spec.Operation)
+	op.ID = "getTask"
+
+	// the reader gets the consumer for the media type of the response
+	var result struct {
+		ID      int64  `xml:"id"`
+		Content string `xml:"content"`
+	}
+	err := newRuntime(server).Submit(&Request{Method: "GET", Path: "/tasks/1", Operation: op}, ResponseReaderFunc(func(reader io.Reader, consumer httpkit.Consumer) error {
+		return consumer.Consume(reader, &result)
+	}))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, result.ID)
+	assert.Equal(t, "do the dishes", result.Content)
+}
+
+func TestRuntimeSubmitURLEncodedForm(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get(httpkit.HeaderContentType))
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "do the dishes", r.PostForm.Get("content"))
+		assert.Equal(t, "1,2", r.PostForm.Get("ids"))
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	op := new(spec.Operation)
+	op.ID = "createTask"
+	op.Parameters = []spec.Parameter{
+		*spec.FormDataParam("content").Typed("string", ""),
+		*spec.FormDataParam("ids").CollectionOf(spec.NewItems().Typed("integer", "int32"), "csv"),
+	}
+
+	err := newRuntime(server).Submit(&Request{
+		Method:    "POST",
+		Path:      "/tasks",
+		Operation: op,
+		Params: map[string]interface{}{
+			"content": "do the dishes",
+			"ids":     []int32{1, 2},
+		},
+	}, nil)
+	assert.NoError(t, err)
+}
+
+func TestRuntimeSubmitMultipartForm(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseMultipartForm(1024))
+		assert.Equal(t, "a file", r.FormValue("description"))
+
+		f, fh, err := r.FormFile("attachment")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "attachment", fh.Filename)
+			b, _ := ioutil.ReadAll(f)
+			assert.Equal(t, "the file content", string(b))
+		}
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	op := new(spec.Operation)
+	op.ID = "uploadAttachment"
+	op.Parameters = []spec.Parameter{
+		*spec.FormDataParam("description").Typed("string", ""),
+		*spec.FileParam("attachment"),
+	}
+
+	err := newRuntime(server).Submit(&Request{
+		Method:    "POST",
+		Path:      "/tasks/attachments",
+		Operation: op,
+		Params: map[string]interface{}{
+			"description": "a file",
+			"attachment":  bytes.NewBufferString("the file content"),
+		},
+	}, nil)
+	assert.NoError(t, err)
+}
+
+func TestRuntimeSubmitErrorModel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set(httpkit.HeaderContentType, httpkit.JSONMime)
+		rw.WriteHeader(http.StatusNotFound)
+		rw.Write([]byte(`{"id":404,"content":"not found"}`))
+	}))
+	defer server.Close()
+
+	op := new(spec.Operation)
+	op.ID = "getTask"
+
+	var errorModel task
+	err := newRuntime(server).Submit(&Request{
+		Method:     "GET",
+		Path:       "/tasks/1",
+		Operation:  op,
+		ErrorModel: &errorModel,
+	}, nil)
+
+	if assert.Error(t, err) {
+		apiErr, ok := err.(*APIError)
+		if assert.True(t, ok) {
+			assert.Equal(t, http.StatusNotFound, apiErr.Code)
+			assert.Equal(t, "getTask", apiErr.OperationName)
+			assert.Equal(t, &errorModel, apiErr.Value)
+			assert.Equal(t, "not found", errorModel.Content)
+		}
+	}
+}
+
+func TestRuntimePickScheme(t *testing.T) {
+	rt := New(nil)
+	assert.Equal(t, "http", rt.pickScheme(nil))
+	assert.Equal(t, "https", rt.pickScheme([]string{"http", "https"}))
+
+	rt.Schemes = []string{"ws"}
+	assert.Equal(t, "ws", rt.pickScheme(nil))
+	assert.Equal(t, "http", rt.pickScheme([]string{"http"}))
+}
+
+func TestRuntimeValidateRequest(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		called = true
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	op := new(spec.Operation)
+	op.ID = "listTasks"
+	op.Parameters = []spec.Parameter{
+		*spec.QueryParam("limit").Typed("integer", "int32").WithMaximum(20, false),
+		*spec.QueryParam("status").Typed("string", "").WithEnum("open", "closed"),
+		*spec.HeaderParam("X-Request-Id").Typed("string", ""),
+	}
+
+	rt := newRuntime(server)
+	err := rt.Submit(&Request{
+		Method:    "GET",
+		Path:      "/tasks",
+		Operation: op,
+		Params: map[string]interface{}{
+			"limit":  int32(50),
+			"status": "pending",
+		},
+	}, nil)
+
+	assert.False(t, called)
+	if assert.Error(t, err) {
+		ce, ok := err.(*errors.CompositeError)
+		if assert.True(t, ok) {
+			assert.EqualValues(t, 422, ce.Code())
+			assert.Len(t, ce.Errors, 3)
+		}
+	}
+
+	err = rt.Submit(&Request{
+		Method:    "GET",
+		Path:      "/tasks",
+		Operation: op,
+		Params: map[string]interface{}{
+			"limit":        int32(10),
+			"status":       "open",
+			"X-Request-Id": "trace-id",
+		},
+	}, nil)
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestRuntimeValidateRequestBody(t *testing.T) {
+	schema := new(spec.Schema).Typed("object", "")
+	schema.Required = []string{"content"}
+	schema.Properties = map[string]spec.Schema{
+		"content": *spec.StringProperty(),
+	}
+
+	op := new(spec.Operation)
+	op.ID = "createTask"
+	op.Parameters = []spec.Parameter{*spec.BodyParam("body", schema).AsRequired()}
+
+	rt := New(nil)
+	err := rt.validateRequest(&Request{Operation: op, Params: map[string]interface{}{}})
+	assert.Error(t, err)
+
+	err = rt.validateRequest(&Request{Operation: op, Params: map[string]interface{}{
+		"body": map[string]interface{}{"id": 1},
+	}})
+	assert.Error(t, err)
+
+	err = rt.validateRequest(&Request{Operation: op, Params: map[string]interface{}{
+		"body": task{Content: "do the dishes"},
+	}})
+	assert.NoError(t, err)
+}
+
+const pathParamsSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "tasks", "version": "1"},
+  "basePath": "/api",
+  "parameters": {
+    "requestId": {"name": "X-Request-Id", "in": "header", "type": "string", "required": true}
+  },
+  "paths": {
+    "/tasks/{id}": {
+      "parameters": [{"name": "id", "in": "path", "type": "integer", "format": "int64", "required": true}],
+      "get": {
+        "operationId": "getTask",
+        "parameters": [{"$ref": "#/parameters/requestId"}],
+        "responses": {"204": {"description": "the task"}}
+      }
+    }
+  }
+}`
+
+func TestRuntimeSubmitPathLevelParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tasks/12", r.URL.Path)
+		assert.Equal(t, "trace-id", r.Header.Get("X-Request-Id"))
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	doc, err := spec.New(json.RawMessage(pathParamsSpec), "")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	op, _ := doc.OperationForName("getTask")
+
+	rt := newRuntime(server)
+	rt.Spec = doc
+
+	// the path parameter is declared on the path and the header is referred to, both are validated and sent
+	err = rt.Submit(&Request{Method: "GET", Path: "/tasks/{id}", Operation: op, Params: map[string]interface{}{}}, nil)
+	if assert.Error(t, err) {
+		ce, ok := err.(*errors.CompositeError)
+		if assert.True(t, ok) {
+			assert.Len(t, ce.Errors, 2)
+		}
+	}
+
+	err = rt.Submit(&Request{
+		Method:    "GET",
+		Path:      "/tasks/{id}",
+		Operation: op,
+		Params: map[string]interface{}{
+			"id":           int64(12),
+			"X-Request-Id": "trace-id",
+		},
+	}, nil)
+	assert.NoError(t, err)
+}
@@ -14,4 +14,12 @@ const (
 	JSONMime = "application/json"
 	// YAMLMime the yaml mime type
 	YAMLMime = "application/x-yaml"
+	// XMLMime the xml mime type
+	XMLMime = "application/xml"
+	// TextMime the text mime type
+	TextMime = "text/plain"
+	// CSVMime the csv mime type
+	CSVMime = "text/csv"
+	// ByteStreamMime the byte stream mime type
+	ByteStreamMime = "application/octet-stream"
 )
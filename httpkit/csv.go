@@ -0,0 +1,217 @@
+package httpkit
+
+import (
+	"encoding"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// CSVConsumer creates a new CSV consumer.
+// It reads the records into a [][]string, or into a slice of structs
+// where the first record has the names of the fields in the json tags of the struct.
+func CSVConsumer() Consumer {
+	return ConsumerFunc(func(reader io.Reader, data interface{}) error {
+		records, err := csv.NewReader(reader).ReadAll()
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return io.EOF
+		}
+
+		switch v := data.(type) {
+		case *[][]string:
+			*v = records
+			return nil
+		case *interface{}:
+			*v = records
+			return nil
+		}
+
+		val := reflect.ValueOf(data)
+		if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Slice || structType(val.Elem().Type().Elem()) == nil {
+			return fmt.Errorf("%T is not supported by the csv consumer", data)
+		}
+		return readCSVStructs(records, val.Elem())
+	})
+}
+
+// CSVProducer creates a new CSV producer.
+// It writes a [][]string as it is, and a slice of structs as a header record with the names of the fields
+// in the json tags of the struct followed by a record per struct.
+func CSVProducer() Producer {
+	return ProducerFunc(func(writer io.Writer, data interface{}) error {
+		w := csv.NewWriter(writer)
+		if records, ok := data.([][]string); ok {
+			return w.WriteAll(records)
+		}
+
+		val := reflect.Indirect(reflect.ValueOf(data))
+		if val.Kind() != reflect.Slice || structType(val.Type().Elem()) == nil {
+			return fmt.Errorf("%T is not supported by the csv producer", data)
+		}
+		fields := csvFields(structType(val.Type().Elem()))
+
+		header := make([]string, len(fields))
+		for i, f := range fields {
+			header[i] = f.name
+		}
+		if err := w.Write(header); err != nil {
+			return err
+		}
+
+		for i := 0; i < val.Len(); i++ {
+			item := reflect.Indirect(val.Index(i))
+			record := make([]string, len(fields))
+			if item.IsValid() {
+				for j, f := range fields {
+					s, err := formatCSVValue(item.Field(f.index))
+					if err != nil {
+						return err
+					}
+					record[j] = s
+				}
+			}
+			if err := w.Write(record); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+	})
+}
+
+// structType returns the struct type for a struct or a pointer to a struct, nil for anything else
+func structType(tpe reflect.Type) reflect.Type {
+	if tpe.Kind() == reflect.Ptr {
+		tpe = tpe.Elem()
+	}
+	if tpe.Kind() != reflect.Struct {
+		return nil
+	}
+	return tpe
+}
+
+type csvField struct {
+	name  string
+	index int
+}
+
+// csvFields gets the exported fields of a struct with the names in their json tags
+func csvFields(tpe reflect.Type) []csvField {
+	var fields []csvField
+	for i := 0; i < tpe.NumField(); i++ {
+		f := tpe.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		name := f.Name
+		if tag := f.Tag.Get("json"); tag != "" {
+			tn := strings.Split(tag, ",")[0]
+			if tn == "-" {
+				continue
+			}
+			if tn != "" {
+				name = tn
+			}
+		}
+		fields = append(fields, csvField{name: name, index: i})
+	}
+	return fields
+}
+
+func formatCSVValue(val reflect.Value) (string, error) {
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return "", nil
+		}
+		val = val.Elem()
+	}
+	if m, ok := val.Interface().(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		return string(b), err
+	}
+	return fmt.Sprint(val.Interface()), nil
+}
+
+func readCSVStructs(records [][]string, target reflect.Value) error {
+	itemType := target.Type().Elem()
+	fields := make(map[string]int)
+	for _, f := range csvFields(structType(itemType)) {
+		fields[f.name] = f.index
+	}
+
+	header := records[0]
+	result := reflect.MakeSlice(target.Type(), 0, len(records)-1)
+	for _, record := range records[1:] {
+		item := reflect.New(structType(itemType)).Elem()
+		for i, value := range record {
+			if i >= len(header) {
+				break
+			}
+			index, ok := fields[header[i]]
+			if !ok {
+				continue
+			}
+			if err := parseCSVValue(value, item.Field(index)); err != nil {
+				return fmt.Errorf("column %s: %v", header[i], err)
+			}
+		}
+		if itemType.Kind() == reflect.Ptr {
+			item = item.Addr()
+		}
+		result = reflect.Append(result, item)
+	}
+	target.Set(result)
+	return nil
+}
+
+// parseCSVValue sets a field from the value in a record, empty values leave the field at its zero value
+func parseCSVValue(value string, field reflect.Value) error {
+	if value == "" {
+		return nil
+	}
+	if field.Kind() == reflect.Ptr {
+		field.Set(reflect.New(field.Type().Elem()))
+		field = field.Elem()
+	}
+	if u, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(value))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(n)
+	default:
+		return fmt.Errorf("%s is not supported by the csv consumer", field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package httpkit
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type csvTask struct {
+	ID       int64   `json:"id"`
+	Name     string  `json:"name,omitempty"`
+	Done     bool    `json:"done"`
+	Estimate *string `json:"estimate"`
+	secret   string
+	Ignored  string `json:"-"`
+}
+
+var consProdCSV = "id,name,done,estimate\n1,write tests,true,\n2,\"ship it, finally\",false,2d\n"
+
+func TestCSVConsumer(t *testing.T) {
+	cons := CSVConsumer()
+
+	var records [][]string
+	err := cons.Consume(bytes.NewBufferString(consProdCSV), &records)
+	assert.NoError(t, err)
+	assert.Len(t, records, 3)
+	assert.Equal(t, []string{"2", "ship it, finally", "false", "2d"}, records[2])
+
+	var tasks []csvTask
+	err = cons.Consume(bytes.NewBufferString(consProdCSV), &tasks)
+	assert.NoError(t, err)
+	if assert.Len(t, tasks, 2) {
+		assert.Equal(t, csvTask{ID: 1, Name: "write tests", Done: true}, tasks[0])
+		assert.Equal(t, int64(2), tasks[1].ID)
+		if assert.NotNil(t, tasks[1].Estimate) {
+			assert.Equal(t, "2d", *tasks[1].Estimate)
+		}
+	}
+
+	var ptrs []*csvTask
+	err = cons.Consume(bytes.NewBufferString(consProdCSV), &ptrs)
+	assert.NoError(t, err)
+	assert.Len(t, ptrs, 2)
+
+	err = cons.Consume(bytes.NewBufferString("id\nnot a number\n"), &tasks)
+	assert.Error(t, err)
+
+	var str string
+	assert.Error(t, cons.Consume(bytes.NewBufferString(consProdCSV), &str))
+}
+
+func TestCSVProducer(t *testing.T) {
+	prod := CSVProducer()
+	estimate := "2d"
+	tasks := []csvTask{
+		{ID: 1, Name: "write tests", Done: true},
+		{ID: 2, Name: "ship it, finally", Estimate: &estimate, secret: "hidden", Ignored: "ignored"},
+	}
+
+	rw := httptest.NewRecorder()
+	err := prod.Produce(rw, tasks)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdCSV, rw.Body.String())
+
+	rw = httptest.NewRecorder()
+	err = prod.Produce(rw, [][]string{{"id", "name"}, {"1", "write tests"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "id,name\n1,write tests\n", rw.Body.String())
+
+	rw = httptest.NewRecorder()
+	assert.Error(t, prod.Produce(rw, "not a slice"))
+}
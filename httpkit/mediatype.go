@@ -0,0 +1,108 @@
+package httpkit
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/golang/gddo/httputil/header"
+)
+
+// the media types structured syntax suffixes stand for, application/vnd.acme+json is also application/json
+var suffixMediaTypes = map[string]string{
+	"json": JSONMime,
+	"xml":  XMLMime,
+	"yaml": YAMLMime,
+}
+
+// specificity of a match between a media type and a pattern, lower is better
+const (
+	exactMatch = iota
+	suffixMatch
+	subtypeWildcardMatch
+	wildcardMatch
+	noMatch
+)
+
+// baseMediaType returns the lowercased media type without its parameters
+func baseMediaType(mediaType string) string {
+	if mt, _, err := mime.ParseMediaType(mediaType); err == nil {
+		return mt
+	}
+	if i := strings.IndexByte(mediaType, ';'); i >= 0 {
+		mediaType = mediaType[:i]
+	}
+	return strings.ToLower(strings.TrimSpace(mediaType))
+}
+
+// SuffixMediaType returns the media type for the structured syntax suffix of a media type,
+// for example application/json for application/vnd.acme.v2+json.
+// The second return value is false when the media type has no known suffix.
+func SuffixMediaType(mediaType string) (string, bool) {
+	mt := baseMediaType(mediaType)
+	i := strings.LastIndexByte(mt, '+')
+	if i < 0 {
+		return "", false
+	}
+	base, ok := suffixMediaTypes[mt[i+1:]]
+	return base, ok
+}
+
+func matchMediaType(pattern, mediaType string) int {
+	pt, mt := baseMediaType(pattern), baseMediaType(mediaType)
+	switch {
+	case pt == mt:
+		return exactMatch
+	case pt == "*/*" || pt == "*":
+		return wildcardMatch
+	case strings.HasSuffix(pt, "/*") && strings.HasPrefix(mt, pt[:len(pt)-1]):
+		return subtypeWildcardMatch
+	}
+	if base, ok := SuffixMediaType(mt); ok && base == pt {
+		return suffixMatch
+	}
+	return noMatch
+}
+
+// MatchMediaType returns true when the media type is matched by the pattern.
+// Parameters like the charset are ignored, the pattern can be a wildcard like */* or application/*
+// and a media type with a structured syntax suffix like application/vnd.acme+json is matched by application/json.
+func MatchMediaType(pattern, mediaType string) bool {
+	return matchMediaType(pattern, mediaType) != noMatch
+}
+
+// LookupMediaType returns the pattern that matches the media type best,
+// an exact match is preferred over a suffix match, which is preferred over wildcards.
+func LookupMediaType(patterns []string, mediaType string) (string, bool) {
+	best, bestMatch := "", noMatch
+	for _, pattern := range patterns {
+		if m := matchMediaType(pattern, mediaType); m < bestMatch {
+			best, bestMatch = pattern, m
+		}
+	}
+	return best, bestMatch != noMatch
+}
+
+// NegotiateContentType returns the best offered content type for the accept header of the request.
+// This understands wildcards in the accept header and structured syntax suffixes in the offers,
+// offers with parameters like a charset are returned as they are.
+// When none of the offers is acceptable the default offer is returned.
+func NegotiateContentType(r *http.Request, offers []string, defaultOffer string) string {
+	specs := header.ParseAccept(r.Header, HeaderAccept)
+	best, bestQ, bestMatch := defaultOffer, -1.0, noMatch
+	for _, offer := range offers {
+		for _, spec := range specs {
+			if spec.Q == 0 {
+				continue
+			}
+			m := matchMediaType(spec.Value, offer)
+			if m == noMatch {
+				continue
+			}
+			if spec.Q > bestQ || (spec.Q == bestQ && m < bestMatch) {
+				best, bestQ, bestMatch = offer, spec.Q, m
+			}
+		}
+	}
+	return best
+}
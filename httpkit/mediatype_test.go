@@ -0,0 +1,73 @@
+package httpkit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchMediaType(t *testing.T) {
+	assert.True(t, MatchMediaType("application/json", "application/json"))
+	assert.True(t, MatchMediaType("application/json", "Application/JSON; charset=utf-8"))
+	assert.True(t, MatchMediaType("application/json", "application/vnd.acme.v2+json"))
+	assert.True(t, MatchMediaType("application/xml", "application/atom+xml"))
+	assert.True(t, MatchMediaType("application/*", "application/x-yaml"))
+	assert.True(t, MatchMediaType("*/*", "text/csv"))
+	assert.False(t, MatchMediaType("application/vnd.acme.v2+json", "application/json"))
+	assert.False(t, MatchMediaType("text/*", "application/json"))
+	assert.False(t, MatchMediaType("application/json", "application/xml"))
+
+	base, ok := SuffixMediaType("application/vnd.acme.v2+json; charset=utf-8")
+	assert.True(t, ok)
+	assert.Equal(t, JSONMime, base)
+	_, ok = SuffixMediaType("application/vnd.acme.v2+zip")
+	assert.False(t, ok)
+}
+
+func TestLookupMediaType(t *testing.T) {
+	patterns := []string{"*/*", "application/*", JSONMime, "application/vnd.acme.v2+json"}
+
+	mt, ok := LookupMediaType(patterns, "application/vnd.acme.v2+json")
+	assert.True(t, ok)
+	assert.Equal(t, "application/vnd.acme.v2+json", mt)
+
+	mt, ok = LookupMediaType(patterns, "application/vnd.acme.v3+json")
+	assert.True(t, ok)
+	assert.Equal(t, JSONMime, mt)
+
+	mt, ok = LookupMediaType(patterns, "application/x-yaml")
+	assert.True(t, ok)
+	assert.Equal(t, "application/*", mt)
+
+	mt, ok = LookupMediaType(patterns, "text/plain")
+	assert.True(t, ok)
+	assert.Equal(t, "*/*", mt)
+
+	_, ok = LookupMediaType([]string{JSONMime}, "text/plain")
+	assert.False(t, ok)
+}
+
+func TestNegotiateContentType(t *testing.T) {
+	offers := []string{"application/vnd.acme.v2+json", "text/plain; charset=utf-8"}
+	data := []struct {
+		accept   string
+		expected string
+	}{
+		{"", ""},
+		{"application/vnd.acme.v2+json", "application/vnd.acme.v2+json"},
+		{"application/json", "application/vnd.acme.v2+json"},
+		{"text/plain", "text/plain; charset=utf-8"},
+		{"text/*, application/json;q=0.5", "text/plain; charset=utf-8"},
+		{"*/*", "application/vnd.acme.v2+json"},
+		{"image/png", ""},
+	}
+
+	for _, v := range data {
+		r, _ := http.NewRequest("GET", "/", nil)
+		if v.accept != "" {
+			r.Header.Set(HeaderAccept, v.accept)
+		}
+		assert.Equal(t, v.expected, NegotiateContentType(r, offers, ""), "accept: %q", v.accept)
+	}
+}
@@ -2,14 +2,14 @@ package middleware
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/go-swagger/go-swagger/errors"
 	"github.com/go-swagger/go-swagger/httpkit"
 	"github.com/go-swagger/go-swagger/httpkit/middleware/untyped"
+	"github.com/go-swagger/go-swagger/httpkit/security"
 	"github.com/go-swagger/go-swagger/spec"
 	"github.com/go-swagger/go-swagger/strfmt"
-	"github.com/golang/gddo/httputil"
-	"github.com/gorilla/context"
 )
 
 // RequestBinder is an interface for types to implement
@@ -18,13 +18,33 @@ type RequestBinder interface {
 	BindRequest(*http.Request, *MatchedRoute) error
 }
 
-// Context is a type safe wrapper around an untyped request context
-// used throughout to store request context with the gorilla context module
+// Responder is an interface for types to implement
+// when they want to be considered for writing HTTP responses.
+// A responder writes the status code, headers and payload of one of the responses declared for the operation.
+type Responder interface {
+	WriteResponse(http.ResponseWriter, httpkit.Producer)
+}
+
+// ResponderFunc wraps a func as a Responder interface
+type ResponderFunc func(http.ResponseWriter, httpkit.Producer)
+
+// WriteResponse writes to the response
+func (fn ResponderFunc) WriteResponse(rw http.ResponseWriter, pr httpkit.Producer) {
+	fn(rw, pr)
+}
+
+// Context is a type safe wrapper around an untyped request context,
+// the values it resolves for a request are stored in the context of the request
 type Context struct {
-	spec    *spec.Document
-	api     RoutableAPI
-	router  Router
-	formats strfmt.Registry
+	spec               *spec.Document
+	api                RoutableAPI
+	router             Router
+	formats            strfmt.Registry
+	responseValidation ResponseValidation
+	metrics            MetricsSink
+	panicCallback      PanicCallback
+	specRewriter       SpecRewriter
+	cors               *CORSOpts
 }
 
 type routableUntypedAPI struct {
@@ -48,32 +68,7 @@ func newRoutableUntypedAPI(spec *spec.Document, api *untyped.API, context *Conte
 					handlers = make(map[string]http.Handler)
 				}
 
-				handlers[op.ID] = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-					// lookup route info in the context
-					route, _ := context.RouteInfo(r)
-
-					// bind and validate the request using reflection
-					bound, validation := context.BindAndValidate(r, route)
-					if validation != nil {
-						context.Respond(w, r, route.Produces, route, validation)
-						return
-					}
-
-					// actually handle the request
-					result, err := oh.Handle(bound)
-					if err != nil {
-						// respond with failure
-						context.Respond(w, r, route.Produces, route, err)
-						return
-					}
-
-					// respond with success
-					context.Respond(w, r, route.Produces, route, result)
-				})
-
-				if len(schemes) > 0 {
-					handlers[op.ID] = newSecureAPI(context, handlers[op.ID])
-				}
+				handlers[op.ID] = newUntypedOperationHandler(context, op, oh, api.InterceptorsFor(op.ID), len(schemes) > 0)
 			}
 		}
 	}
@@ -114,15 +109,17 @@ func (r *routableUntypedAPI) DefaultConsumes() string {
 	return r.defaultConsumes
 }
 
-// NewRoutableContext creates a new context for a routable API
+// NewRoutableContext creates a new context for a routable API.
+// When routes is nil the default router is built from the spec.
 func NewRoutableContext(spec *spec.Document, routableAPI RoutableAPI, routes Router) *Context {
-	ctx := &Context{spec: spec, api: routableAPI}
+	ctx := &Context{spec: spec, api: routableAPI, router: routes}
 	return ctx
 }
 
-// NewContext creates a new context wrapper
+// NewContext creates a new context wrapper.
+// When routes is nil the default router is built from the spec.
 func NewContext(spec *spec.Document, api *untyped.API, routes Router) *Context {
-	ctx := &Context{spec: spec}
+	ctx := &Context{spec: spec, router: routes}
 	ctx.api = newRoutableUntypedAPI(spec, api, ctx)
 	return ctx
 }
@@ -133,20 +130,6 @@ func Serve(spec *spec.Document, api *untyped.API) http.Handler {
 	return context.APIHandler()
 }
 
-type contextKey int8
-
-const (
-	_ contextKey = iota
-	ctxContentType
-	ctxResponseFormat
-	ctxMatchedRoute
-	ctxAllowedMethods
-	ctxBoundParams
-	ctxSecurityPrincipal
-
-	ctxConsumer
-)
-
 type contentTypeValue struct {
 	MediaType string
 	Charset   string
@@ -176,13 +159,13 @@ func (c *Context) BindValidRequest(request *http.Request, route *MatchedRoute, b
 			if err := validateContentType(route.Consumes, ct); err != nil {
 				res = append(res, err)
 			}
-			route.Consumer = route.Consumers[ct]
+			route.Consumer = consumerFor(route.Consumers, ct)
 		}
 	}
 
 	// check and validate the response format
 	if len(res) == 0 {
-		if str := httputil.NegotiateContentType(request, route.Produces, ""); str == "" {
+		if str := httpkit.NegotiateContentType(request, route.Produces, ""); str == "" {
 			res = append(res, errors.InvalidResponseFormat(request.Header.Get(httpkit.HeaderAccept), route.Produces))
 		}
 	}
@@ -204,17 +187,18 @@ func (c *Context) BindValidRequest(request *http.Request, route *MatchedRoute, b
 
 // ContentType gets the parsed value of a content type
 func (c *Context) ContentType(request *http.Request) (string, string, *errors.ParseError) {
-	if v, ok := context.GetOk(request, ctxContentType); ok {
-		if val, ok := v.(*contentTypeValue); ok {
-			return val.MediaType, val.Charset, nil
-		}
+	state := requestStateFrom(request)
+	if state != nil && state.contentType != nil {
+		return state.contentType.MediaType, state.contentType.Charset, nil
 	}
 
 	mt, cs, err := httpkit.ContentType(request.Header)
 	if err != nil {
 		return "", "", err
 	}
-	context.Set(request, ctxContentType, &contentTypeValue{mt, cs})
+	if state != nil {
+		state.contentType = &contentTypeValue{mt, cs}
+	}
 	return mt, cs, nil
 }
 
@@ -228,14 +212,15 @@ func (c *Context) LookupRoute(request *http.Request) (*MatchedRoute, bool) {
 
 // RouteInfo tries to match a route for this request
 func (c *Context) RouteInfo(request *http.Request) (*MatchedRoute, bool) {
-	if v, ok := context.GetOk(request, ctxMatchedRoute); ok {
-		if val, ok := v.(*MatchedRoute); ok {
-			return val, ok
-		}
+	state := requestStateFrom(request)
+	if state != nil && state.route != nil {
+		return state.route, true
 	}
 
 	if route, ok := c.LookupRoute(request); ok {
-		context.Set(request, ctxMatchedRoute, route)
+		if state != nil {
+			state.route = route
+		}
 		return route, ok
 	}
 
@@ -244,15 +229,14 @@ func (c *Context) RouteInfo(request *http.Request) (*MatchedRoute, bool) {
 
 // ResponseFormat negotiates the response content type
 func (c *Context) ResponseFormat(r *http.Request, offers []string) string {
-	if v, ok := context.GetOk(r, ctxResponseFormat); ok {
-		if val, ok := v.(string); ok {
-			return val
-		}
+	state := requestStateFrom(r)
+	if state != nil && state.responseFormat != "" {
+		return state.responseFormat
 	}
 
-	format := httputil.NegotiateContentType(r, offers, "")
-	if format != "" {
-		context.Set(r, ctxResponseFormat, format)
+	format := httpkit.NegotiateContentType(r, offers, "")
+	if format != "" && state != nil {
+		state.responseFormat = format
 	}
 	return format
 }
@@ -267,35 +251,83 @@ func (c *Context) Authorize(request *http.Request, route *MatchedRoute) (interfa
 	if len(route.Authenticators) == 0 {
 		return nil, nil
 	}
-	if v, ok := context.GetOk(request, ctxSecurityPrincipal); ok {
-		return v, nil
+	state := requestStateFrom(request)
+	if state != nil && state.principal != nil {
+		return state.principal, nil
 	}
 
-	for _, authenticator := range route.Authenticators {
-		applies, usr, err := authenticator.Authenticate(request)
-		if !applies || err != nil || usr == nil {
+	// the security requirements are alternatives, each of them is a set of schemes that all need to pass.
+	// An empty set allows anonymous access, that's only used when none of the other sets authenticates.
+	var forbidden error
+	var anonymous bool
+	for _, requirements := range route.Security {
+		if len(requirements) == 0 {
+			anonymous = true
 			continue
 		}
-		context.Set(request, ctxSecurityPrincipal, usr)
+		usr, err := c.authorizeRequirements(request, route, requirements)
+		if err != nil {
+			if e, ok := err.(errors.Error); ok && e.Code() == http.StatusForbidden {
+				forbidden = err
+			}
+			continue
+		}
+		if usr == nil {
+			continue
+		}
+		if state != nil {
+			state.principal = usr
+		}
 		return usr, nil
 	}
 
+	if anonymous {
+		return nil, nil
+	}
+	if forbidden != nil {
+		return nil, forbidden
+	}
 	return nil, errors.Unauthenticated("invalid credentials")
 }
 
+// authorizeRequirements authenticates every scheme of a security requirement set,
+// the principal of the first scheme in the set is used for the request
+func (c *Context) authorizeRequirements(request *http.Request, route *MatchedRoute, requirements []spec.SecurityRequirement) (interface{}, error) {
+	var principal interface{}
+	for _, requirement := range requirements {
+		authenticator, ok := route.Authenticators[requirement.Name]
+		if !ok {
+			return nil, nil
+		}
+
+		var params interface{} = request
+		if len(requirement.Scopes) > 0 {
+			params = &security.ScopedAuthRequest{Request: request, RequiredScopes: requirement.Scopes}
+		}
+
+		applies, usr, err := authenticator.Authenticate(params)
+		if !applies || err != nil || usr == nil {
+			return nil, err
+		}
+		if principal == nil {
+			principal = usr
+		}
+	}
+	return principal, nil
+}
+
 // BindAndValidate binds and validates the request
 func (c *Context) BindAndValidate(request *http.Request, matched *MatchedRoute) (interface{}, error) {
-	if v, ok := context.GetOk(request, ctxBoundParams); ok {
-		if val, ok := v.(*validation); ok {
-			if len(val.result) > 0 {
-				return val.bound, errors.CompositeValidationError(val.result...)
-			}
-			return val.bound, nil
+	state := requestStateFrom(request)
+	if state != nil && state.bound != nil {
+		if len(state.bound.result) > 0 {
+			return state.bound.bound, errors.CompositeValidationError(state.bound.result...)
 		}
+		return state.bound.bound, nil
 	}
 	result := validateRequest(c, request, matched)
-	if result != nil {
-		context.Set(request, ctxBoundParams, result)
+	if result != nil && state != nil {
+		state.bound = result
 	}
 	if len(result.result) > 0 {
 		return result.bound, errors.CompositeValidationError(result.result...)
@@ -324,6 +356,9 @@ func (c *Context) Respond(rw http.ResponseWriter, r *http.Request, produces []st
 		if format == "" {
 			rw.Header().Set(httpkit.HeaderContentType, httpkit.JSONMime)
 		}
+		if e, ok := err.(*errors.MethodNotAllowedError); ok {
+			rw.Header().Set("Allow", strings.Join(e.Allowed, ","))
+		}
 		if route == nil || route.Operation == nil {
 			c.api.ServeErrorFor("")(rw, r, err)
 			return
@@ -331,13 +366,29 @@ func (c *Context) Respond(rw http.ResponseWriter, r *http.Request, produces []st
 		c.api.ServeErrorFor(route.Operation.ID)(rw, r, err)
 		return
 	}
+	if resp, ok := data.(Responder); ok {
+		producers := c.api.ProducersFor(offers)
+		if route != nil {
+			producers = route.Producers
+		}
+		prod, ok := producerFor(producers, format)
+		if !ok {
+			panic(errors.New(http.StatusInternalServerError, "can't find a producer for "+format))
+		}
+		if route == nil || route.Operation == nil {
+			resp.WriteResponse(rw, prod)
+			return
+		}
+		resp.WriteResponse(&declaredResponseWriter{ResponseWriter: rw, context: c, request: r, route: route}, prod)
+		return
+	}
 	if route == nil || route.Operation == nil {
 		rw.WriteHeader(200)
 		if r.Method == "HEAD" {
 			return
 		}
 		producers := c.api.ProducersFor(offers)
-		prod, ok := producers[format]
+		prod, ok := producerFor(producers, format)
 		if !ok {
 			panic(errors.New(http.StatusInternalServerError, "can't find a producer for "+format))
 		}
@@ -354,7 +405,7 @@ func (c *Context) Respond(rw http.ResponseWriter, r *http.Request, produces []st
 		}
 
 		producers := route.Producers
-		prod, ok := producers[format]
+		prod, ok := producerFor(producers, format)
 		if !ok {
 			panic(errors.New(http.StatusInternalServerError, "can't find a producer for "+format))
 		}
@@ -366,7 +417,59 @@ func (c *Context) Respond(rw http.ResponseWriter, r *http.Request, produces []st
 	c.api.ServeErrorFor(route.Operation.ID)(rw, r, errors.New(http.StatusInternalServerError, "can't produce response"))
 }
 
+// declaredResponseWriter makes sure a responder only writes the status codes declared for the operation,
+// a response with any other status code is replaced with an internal server error
+type declaredResponseWriter struct {
+	http.ResponseWriter
+	context     *Context
+	request     *http.Request
+	route       *MatchedRoute
+	wroteHeader bool
+	rejected    bool
+}
+
+func (d *declaredResponseWriter) WriteHeader(code int) {
+	if d.wroteHeader {
+		return
+	}
+	d.wroteHeader = true
+
+	if responses := d.route.Operation.Responses; responses != nil && responses.Default == nil {
+		if _, ok := responses.StatusCodeResponses[code]; !ok {
+			d.rejected = true
+			d.Header().Set(httpkit.HeaderContentType, httpkit.JSONMime)
+			err := errors.New(http.StatusInternalServerError, "response status %d is not declared for operation %s", code, d.route.Operation.ID)
+			d.context.api.ServeErrorFor(d.route.Operation.ID)(d.ResponseWriter, d.request, err)
+			return
+		}
+	}
+	d.ResponseWriter.WriteHeader(code)
+}
+
+func (d *declaredResponseWriter) Write(data []byte) (int, error) {
+	if !d.wroteHeader {
+		d.WriteHeader(http.StatusOK)
+	}
+	if d.rejected {
+		return len(data), nil
+	}
+	return d.ResponseWriter.Write(data)
+}
+
 // APIHandler returns a handler to serve
 func (c *Context) APIHandler() http.Handler {
-	return specMiddleware(c, newRouter(c, newOperationExecutor(c)))
+	return specMiddleware(c, newRouter(c, c.operationHandler()))
+}
+
+// operationHandler executes the matched route, the route needs to be known in the request context
+func (c *Context) operationHandler() http.Handler {
+	handler := newOperationExecutor(c)
+	if c.responseValidation != NoResponseValidation {
+		handler = newResponseValidation(c, handler)
+	}
+	handler = newRecovery(c, handler)
+	if c.metrics != nil {
+		handler = newMetrics(c, handler)
+	}
+	return handler
 }
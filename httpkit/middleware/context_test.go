@@ -8,7 +8,6 @@ import (
 
 	"github.com/go-swagger/go-swagger/httpkit"
 	"github.com/go-swagger/go-swagger/internal/testing/petstore"
-	"github.com/gorilla/context"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -38,10 +37,9 @@ func TestContextAuthorize(t *testing.T) {
 	ctx.router = DefaultRouter(spec, ctx.api)
 
 	request, _ := httpkit.JSONRequest("GET", "/pets", nil)
+	request = withRequestState(request)
 
-	v, ok := context.GetOk(request, ctxSecurityPrincipal)
-	assert.False(t, ok)
-	assert.Nil(t, v)
+	assert.Nil(t, SecurityPrincipalFrom(request))
 
 	ri, ok := ctx.RouteInfo(request)
 	assert.True(t, ok)
@@ -49,27 +47,21 @@ func TestContextAuthorize(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, p)
 
-	v, ok = context.GetOk(request, ctxSecurityPrincipal)
-	assert.False(t, ok)
-	assert.Nil(t, v)
+	assert.Nil(t, SecurityPrincipalFrom(request))
 
 	request.SetBasicAuth("wrong", "wrong")
 	p, err = ctx.Authorize(request, ri)
 	assert.Error(t, err)
 	assert.Nil(t, p)
 
-	v, ok = context.GetOk(request, ctxSecurityPrincipal)
-	assert.False(t, ok)
-	assert.Nil(t, v)
+	assert.Nil(t, SecurityPrincipalFrom(request))
 
 	request.SetBasicAuth("admin", "admin")
 	p, err = ctx.Authorize(request, ri)
 	assert.NoError(t, err)
 	assert.Equal(t, "admin", p)
 
-	v, ok = context.GetOk(request, ctxSecurityPrincipal)
-	assert.True(t, ok)
-	assert.Equal(t, "admin", v)
+	assert.Equal(t, "admin", SecurityPrincipalFrom(request))
 
 	request.SetBasicAuth("doesn't matter", "doesn't")
 	pp, rr := ctx.Authorize(request, ri)
@@ -83,21 +75,18 @@ func TestContextBindAndValidate(t *testing.T) {
 	ctx.router = DefaultRouter(spec, ctx.api)
 
 	request, _ := http.NewRequest("POST", "/pets", nil)
+	request = withRequestState(request)
 	request.Header.Add("Accept", "*/*")
 	request.Header.Add("content-type", "text/html")
 
-	v, ok := context.GetOk(request, ctxBoundParams)
-	assert.False(t, ok)
-	assert.Nil(t, v)
+	assert.Nil(t, BoundParamsFrom(request))
 
 	ri, _ := ctx.RouteInfo(request)
 	data, result := ctx.BindAndValidate(request, ri) // this requires a much more thorough test
 	assert.NotNil(t, data)
 	assert.NotNil(t, result)
 
-	v, ok = context.GetOk(request, ctxBoundParams)
-	assert.True(t, ok)
-	assert.NotNil(t, v)
+	assert.NotNil(t, BoundParamsFrom(request))
 
 	dd, rr := ctx.BindAndValidate(request, ri)
 	assert.Equal(t, data, dd)
@@ -167,21 +156,18 @@ func TestContextValidResponseFormat(t *testing.T) {
 	ctx.router = DefaultRouter(spec, ctx.api)
 
 	request, _ := http.NewRequest("GET", "http://localhost:8080", nil)
+	request = withRequestState(request)
 	request.Header.Set(httpkit.HeaderAccept, ct)
 
 	// check there's nothing there
-	cached, ok := context.GetOk(request, ctxResponseFormat)
-	assert.False(t, ok)
-	assert.Empty(t, cached)
+	assert.Empty(t, requestStateFrom(request).responseFormat)
 
 	// trigger the parse
 	mt := ctx.ResponseFormat(request, []string{ct})
 	assert.Equal(t, ct, mt)
 
 	// check it was cached
-	cached, ok = context.GetOk(request, ctxResponseFormat)
-	assert.True(t, ok)
-	assert.Equal(t, ct, cached)
+	assert.Equal(t, ct, requestStateFrom(request).responseFormat)
 
 	// check if the cast works and fetch from cache too
 	mt = ctx.ResponseFormat(request, []string{ct})
@@ -196,21 +182,18 @@ func TestContextInvalidResponseFormat(t *testing.T) {
 	ctx.router = DefaultRouter(spec, ctx.api)
 
 	request, _ := http.NewRequest("GET", "http://localhost:8080", nil)
+	request = withRequestState(request)
 	request.Header.Set(httpkit.HeaderAccept, ct)
 
 	// check there's nothing there
-	cached, ok := context.GetOk(request, ctxResponseFormat)
-	assert.False(t, ok)
-	assert.Empty(t, cached)
+	assert.Empty(t, requestStateFrom(request).responseFormat)
 
 	// trigger the parse
 	mt := ctx.ResponseFormat(request, []string{other})
 	assert.Empty(t, mt)
 
 	// check it was cached
-	cached, ok = context.GetOk(request, ctxResponseFormat)
-	assert.False(t, ok)
-	assert.Empty(t, cached)
+	assert.Empty(t, requestStateFrom(request).responseFormat)
 
 	// check if the cast works and fetch from cache too
 	mt = ctx.ResponseFormat(request, []string{other})
@@ -223,18 +206,17 @@ func TestContextValidRoute(t *testing.T) {
 	ctx.router = DefaultRouter(spec, ctx.api)
 
 	request, _ := http.NewRequest("GET", "/pets", nil)
+	request = withRequestState(request)
 
 	// check there's nothing there
-	_, ok := context.GetOk(request, ctxMatchedRoute)
-	assert.False(t, ok)
+	assert.Nil(t, MatchedRouteFrom(request))
 
 	matched, ok := ctx.RouteInfo(request)
 	assert.True(t, ok)
 	assert.NotNil(t, matched)
 
 	// check it was cached
-	_, ok = context.GetOk(request, ctxMatchedRoute)
-	assert.True(t, ok)
+	assert.NotNil(t, MatchedRouteFrom(request))
 
 	matched, ok = ctx.RouteInfo(request)
 	assert.True(t, ok)
@@ -247,18 +229,17 @@ func TestContextInvalidRoute(t *testing.T) {
 	ctx.router = DefaultRouter(spec, ctx.api)
 
 	request, _ := http.NewRequest("DELETE", "pets", nil)
+	request = withRequestState(request)
 
 	// check there's nothing there
-	_, ok := context.GetOk(request, ctxMatchedRoute)
-	assert.False(t, ok)
+	assert.Nil(t, MatchedRouteFrom(request))
 
 	matched, ok := ctx.RouteInfo(request)
 	assert.False(t, ok)
 	assert.Nil(t, matched)
 
 	// check it was cached
-	_, ok = context.GetOk(request, ctxMatchedRoute)
-	assert.False(t, ok)
+	assert.Nil(t, MatchedRouteFrom(request))
 
 	matched, ok = ctx.RouteInfo(request)
 	assert.False(t, ok)
@@ -270,11 +251,11 @@ func TestContextValidContentType(t *testing.T) {
 	ctx := NewContext(nil, nil, nil)
 
 	request, _ := http.NewRequest("GET", "http://localhost:8080", nil)
+	request = withRequestState(request)
 	request.Header.Set(httpkit.HeaderContentType, ct)
 
 	// check there's nothing there
-	_, ok := context.GetOk(request, ctxContentType)
-	assert.False(t, ok)
+	assert.Nil(t, requestStateFrom(request).contentType)
 
 	// trigger the parse
 	mt, _, err := ctx.ContentType(request)
@@ -282,8 +263,7 @@ func TestContextValidContentType(t *testing.T) {
 	assert.Equal(t, ct, mt)
 
 	// check it was cached
-	_, ok = context.GetOk(request, ctxContentType)
-	assert.True(t, ok)
+	assert.NotNil(t, requestStateFrom(request).contentType)
 
 	// check if the cast works and fetch from cache too
 	mt, _, err = ctx.ContentType(request)
@@ -296,11 +276,11 @@ func TestContextInvalidContentType(t *testing.T) {
 	ctx := NewContext(nil, nil, nil)
 
 	request, _ := http.NewRequest("GET", "http://localhost:8080", nil)
+	request = withRequestState(request)
 	request.Header.Set(httpkit.HeaderContentType, ct)
 
 	// check there's nothing there
-	_, ok := context.GetOk(request, ctxContentType)
-	assert.False(t, ok)
+	assert.Nil(t, requestStateFrom(request).contentType)
 
 	// trigger the parse
 	mt, _, err := ctx.ContentType(request)
@@ -308,10 +288,38 @@ func TestContextInvalidContentType(t *testing.T) {
 	assert.Empty(t, mt)
 
 	// check it was not cached
-	_, ok = context.GetOk(request, ctxContentType)
-	assert.False(t, ok)
+	assert.Nil(t, requestStateFrom(request).contentType)
 
 	// check if the failure continues
 	_, _, err = ctx.ContentType(request)
 	assert.Error(t, err)
 }
+
+func TestContextRespondResponder(t *testing.T) {
+	ctx := newTasksContext(t, nil)
+	ctx.router = DefaultRouter(ctx.spec, ctx.api)
+
+	request, _ := http.NewRequest("GET", "/tasks/1", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.JSONMime)
+	ri, ok := ctx.RouteInfo(request)
+	assert.True(t, ok)
+
+	recorder := httptest.NewRecorder()
+	ctx.Respond(recorder, request, ri.Produces, ri, ResponderFunc(func(rw http.ResponseWriter, producer httpkit.Producer) {
+		rw.Header().Set("X-Request-Id", "trace-id")
+		rw.WriteHeader(http.StatusOK)
+		producer.Produce(rw, map[string]interface{}{"id": 1})
+	}))
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "trace-id", recorder.Header().Get("X-Request-Id"))
+	assert.JSONEq(t, `{"id":1}`, recorder.Body.String())
+
+	// the status code of the response needs to be declared for the operation
+	recorder = httptest.NewRecorder()
+	ctx.Respond(recorder, request, ri.Produces, ri, ResponderFunc(func(rw http.ResponseWriter, producer httpkit.Producer) {
+		rw.WriteHeader(http.StatusNotFound)
+		producer.Produce(rw, map[string]interface{}{"message": "not found"})
+	}))
+	assert.Equal(t, 500, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), "not found")
+}
@@ -0,0 +1,160 @@
+package middleware
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-swagger/go-swagger/swag"
+)
+
+// CORSOpts configures the cross origin resource sharing of the API.
+//
+// Preflight requests are answered with the methods the spec declares for the path,
+// the allowed headers are the requested ones among the header parameters and header based security schemes of the operation.
+// The spec served by the API handler gets the cors headers too.
+type CORSOpts struct {
+	// AllowedOrigins are the origins that can make requests to the API, * allows any origin
+	AllowedOrigins []string
+	// AllowCredentials allows requests with cookies and authorization headers
+	AllowCredentials bool
+	// ExposedHeaders are the response headers the client is allowed to read
+	ExposedHeaders []string
+	// MaxAge is how long the client can cache the result of a preflight request
+	MaxAge time.Duration
+}
+
+// SetCORS enables cross origin resource sharing for the API
+func (c *Context) SetCORS(opts CORSOpts) {
+	c.cors = &opts
+}
+
+// allowOrigin returns the value of the Access-Control-Allow-Origin header for the origin,
+// it returns false when the origin isn't allowed
+func (o *CORSOpts) allowOrigin(origin string) (string, bool) {
+	for _, allowed := range o.AllowedOrigins {
+		if allowed == "*" {
+			if o.AllowCredentials {
+				// a wildcard isn't accepted for requests with credentials
+				return origin, true
+			}
+			return "*", true
+		}
+		if strings.EqualFold(allowed, origin) {
+			return origin, true
+		}
+	}
+	return "", false
+}
+
+// writeCORSHeaders writes the headers of a cross origin response, it returns false when the origin isn't allowed
+func (c *Context) writeCORSHeaders(rw http.ResponseWriter, r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return false
+	}
+	rw.Header().Add("Vary", "Origin")
+
+	allowed, ok := c.cors.allowOrigin(origin)
+	if !ok {
+		return false
+	}
+	rw.Header().Set("Access-Control-Allow-Origin", allowed)
+	if c.cors.AllowCredentials {
+		rw.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+	if len(c.cors.ExposedHeaders) > 0 {
+		rw.Header().Set("Access-Control-Expose-Headers", strings.Join(c.cors.ExposedHeaders, ", "))
+	}
+	return true
+}
+
+// servePreflight answers a preflight request for a path of the API,
+// it returns false when the request isn't a preflight request or the path doesn't exist
+func (c *Context) servePreflight(rw http.ResponseWriter, r *http.Request) bool {
+	if !isPreflight(r) {
+		return false
+	}
+
+	methods := c.router.OtherMethods("OPTIONS", r.URL.Path)
+	if len(methods) == 0 {
+		return false
+	}
+
+	// the origin headers were written when the request was routed
+	var headers []string
+	if route, ok := c.router.Lookup(r.Header.Get("Access-Control-Request-Method"), r.URL.Path); ok {
+		headers = c.corsHeaders(route)
+	}
+	c.writePreflight(rw, r, methods, headers)
+	return true
+}
+
+// serveSpecPreflight answers a preflight request for the spec, which can be fetched with GET and HEAD
+func (c *Context) serveSpecPreflight(rw http.ResponseWriter, r *http.Request) {
+	c.writePreflight(rw, r, []string{"GET", "HEAD"}, []string{"Accept"})
+}
+
+// isPreflight returns true when the request is a cors preflight request
+func isPreflight(r *http.Request) bool {
+	return r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != "" && r.Header.Get("Origin") != ""
+}
+
+// writePreflight writes the response to a preflight request with the methods and headers the path accepts.
+// When the client lists the headers it wants to send, only those of them that are accepted are allowed.
+func (c *Context) writePreflight(rw http.ResponseWriter, r *http.Request, methods, headers []string) {
+	if _, ok := c.cors.allowOrigin(r.Header.Get("Origin")); ok {
+		sort.Strings(methods)
+		rw.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ", "))
+		if requested := r.Header.Get("Access-Control-Request-Headers"); requested != "" {
+			headers = requestedHeaders(requested, headers)
+		}
+		if len(headers) > 0 {
+			rw.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ", "))
+		}
+		if c.cors.MaxAge > 0 {
+			rw.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(c.cors.MaxAge.Seconds())))
+		}
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// requestedHeaders returns the headers of the comma separated list that are among the accepted headers
+func requestedHeaders(requested string, accepted []string) []string {
+	var result []string
+	for _, header := range strings.Split(requested, ",") {
+		header = http.CanonicalHeaderKey(strings.TrimSpace(header))
+		if header != "" && !swag.ContainsStringsCI(result, header) && swag.ContainsStringsCI(accepted, header) {
+			result = append(result, header)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// corsHeaders returns the request headers an operation uses
+func (c *Context) corsHeaders(route *MatchedRoute) []string {
+	seen := map[string]bool{"Accept": true, "Content-Type": true}
+	for _, param := range route.Parameters {
+		if param.In == "header" {
+			seen[http.CanonicalHeaderKey(param.Name)] = true
+		}
+	}
+	for _, scheme := range c.spec.SecurityDefinitionsFor(route.Operation) {
+		switch {
+		case scheme.Type == "basic" || scheme.Type == "oauth2":
+			seen["Authorization"] = true
+		case scheme.Type == "apiKey" && scheme.In == "header":
+			seen[http.CanonicalHeaderKey(scheme.Name)] = true
+		}
+	}
+
+	headers := make([]string, 0, len(seen))
+	for header := range seen {
+		headers = append(headers, header)
+	}
+	sort.Strings(headers)
+	return headers
+}
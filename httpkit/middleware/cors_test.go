@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/internal/testing/petstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSPreflight(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	ctx := NewContext(spec, api, nil)
+	ctx.SetCORS(CORSOpts{AllowedOrigins: []string{"https://pets.example.com"}, MaxAge: time.Hour})
+	handler := ctx.APIHandler()
+
+	request, _ := http.NewRequest("OPTIONS", "/api/pets/1", nil)
+	request.Header.Set("Origin", "https://pets.example.com")
+	request.Header.Set("Access-Control-Request-Method", "DELETE")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, "https://pets.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "DELETE, GET, HEAD", recorder.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "Accept, Content-Type, X-Api-Key", recorder.Header().Get("Access-Control-Allow-Headers"))
+	assert.Equal(t, "3600", recorder.Header().Get("Access-Control-Max-Age"))
+	assert.Equal(t, "Origin", recorder.Header().Get("Vary"))
+
+	// the requested headers are allowed when the operation accepts them
+	request, _ = http.NewRequest("OPTIONS", "/api/pets/1", nil)
+	request.Header.Set("Origin", "https://pets.example.com")
+	request.Header.Set("Access-Control-Request-Method", "DELETE")
+	request.Header.Set("Access-Control-Request-Headers", "x-api-key, content-type, x-unknown")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, "Content-Type, X-Api-Key", recorder.Header().Get("Access-Control-Allow-Headers"))
+
+	request.Header.Set("Access-Control-Request-Headers", "x-unknown")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Empty(t, recorder.Header().Get("Access-Control-Allow-Headers"))
+
+	// origins that aren't allowed don't get the cors headers
+	request, _ = http.NewRequest("OPTIONS", "/api/pets/1", nil)
+	request.Header.Set("Origin", "https://evil.example.com")
+	request.Header.Set("Access-Control-Request-Method", "DELETE")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, recorder.Header().Get("Access-Control-Allow-Methods"))
+
+	// unknown paths are still not found
+	request, _ = http.NewRequest("OPTIONS", "/api/nopets", nil)
+	request.Header.Set("Origin", "https://pets.example.com")
+	request.Header.Set("Access-Control-Request-Method", "GET")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestCORSRequest(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	ctx := NewContext(spec, api, nil)
+	ctx.SetCORS(CORSOpts{AllowedOrigins: []string{"*"}, ExposedHeaders: []string{"X-Rate-Limit"}})
+	handler := ctx.APIHandler()
+
+	newRequest := func() *http.Request {
+		request, _ := http.NewRequest("GET", "/api/pets", nil)
+		request.Header.Set("Origin", "https://pets.example.com")
+		request.Header.Set(httpkit.HeaderAccept, httpkit.JSONMime)
+		request.SetBasicAuth("admin", "admin")
+		return request
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest())
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "*", recorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "X-Rate-Limit", recorder.Header().Get("Access-Control-Expose-Headers"))
+
+	// a wildcard isn't used for requests with credentials
+	ctx = NewContext(spec, api, nil)
+	ctx.SetCORS(CORSOpts{AllowedOrigins: []string{"*"}, AllowCredentials: true})
+	handler = ctx.APIHandler()
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest())
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "https://pets.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", recorder.Header().Get("Access-Control-Allow-Credentials"))
+
+	// without cors there are no cors headers
+	recorder = httptest.NewRecorder()
+	NewContext(spec, api, nil).APIHandler().ServeHTTP(recorder, newRequest())
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestCORSSpec(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	ctx := NewContext(spec, api, nil)
+	ctx.SetCORS(CORSOpts{AllowedOrigins: []string{"https://pets.example.com"}})
+	handler := ctx.APIHandler()
+
+	request, _ := http.NewRequest("GET", "/api/swagger.json", nil)
+	request.Header.Set("Origin", "https://pets.example.com")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "https://pets.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.NotEmpty(t, recorder.Body.String())
+
+	request, _ = http.NewRequest("OPTIONS", "/api/swagger.yaml", nil)
+	request.Header.Set("Origin", "https://pets.example.com")
+	request.Header.Set("Access-Control-Request-Method", "GET")
+	request.Header.Set("Access-Control-Request-Headers", "accept, x-api-key")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, "https://pets.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "GET, HEAD", recorder.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "Accept", recorder.Header().Get("Access-Control-Allow-Headers"))
+	assert.Empty(t, recorder.Body.String())
+}
@@ -6,12 +6,12 @@ Pseudo middleware handler
   	"net/http"
 
   	"github.com/go-swagger/go-swagger/errors"
-  	"github.com/gorilla/context"
   )
 
   func newCompleteMiddleware(ctx *Context) http.Handler {
   	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-  		defer context.Clear(r)
+  		// the resolved route, principal and params are stored in the request
+  		r = withRequestState(r)
 
   		// use context to lookup routes
   		if matched, ok := ctx.RouteInfo(r); ok {
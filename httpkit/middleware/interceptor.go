@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/httpkit/middleware/untyped"
+	"github.com/go-swagger/go-swagger/spec"
+)
+
+// newUntypedOperationHandler serves an operation of the untyped API and calls the interceptors for it.
+// The request is authorized when the operation is secured.
+func newUntypedOperationHandler(ctx *Context, operation *spec.Operation, handler httpkit.OperationHandler, interceptors []untyped.Interceptor, secured bool) http.Handler {
+	// the first interceptor ends up as the outermost wrapper
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		if interceptors[i].AroundHandler != nil {
+			handler = interceptors[i].AroundHandler(operation, handler)
+		}
+	}
+
+	var next http.Handler = http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		// lookup route info in the context
+		route, _ := ctx.RouteInfo(r)
+
+		principal := SecurityPrincipalFrom(r)
+		for _, interceptor := range interceptors {
+			if interceptor.AfterAuth == nil {
+				continue
+			}
+			if err := interceptor.AfterAuth(r, operation, principal); err != nil {
+				ctx.Respond(rw, r, route.Produces, route, err)
+				return
+			}
+		}
+
+		// bind and validate the request using reflection
+		var result interface{}
+		bound, validation := ctx.BindAndValidate(r, route)
+		if validation != nil {
+			result = validation
+		} else if data, err := handler.Handle(bound); err != nil {
+			result = err
+		} else {
+			result = data
+		}
+		ctx.Respond(rw, r, route.Produces, route, result)
+
+		for _, interceptor := range interceptors {
+			if interceptor.AfterRespond != nil {
+				interceptor.AfterRespond(r, operation, bound, result)
+			}
+		}
+	})
+
+	if secured {
+		next = newSecureAPI(ctx, next)
+	}
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		for _, interceptor := range interceptors {
+			if interceptor.BeforeBind == nil {
+				continue
+			}
+			if err := interceptor.BeforeBind(r, operation); err != nil {
+				route, _ := ctx.RouteInfo(r)
+				ctx.Respond(rw, r, route.Produces, route, err)
+				return
+			}
+		}
+		next.ServeHTTP(rw, r)
+	})
+}
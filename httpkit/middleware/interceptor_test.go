@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/errors"
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/httpkit/middleware/untyped"
+	"github.com/go-swagger/go-swagger/internal/testing/petstore"
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func recordingInterceptor(name string, calls *[]string) untyped.Interceptor {
+	return untyped.Interceptor{
+		BeforeBind: func(r *http.Request, operation *spec.Operation) error {
+			*calls = append(*calls, name+" before bind "+operation.ID)
+			return nil
+		},
+		AfterAuth: func(r *http.Request, operation *spec.Operation, principal interface{}) error {
+			*calls = append(*calls, name+" after auth "+principal.(string))
+			return nil
+		},
+		AroundHandler: func(operation *spec.Operation, next httpkit.OperationHandler) httpkit.OperationHandler {
+			return httpkit.OperationHandlerFunc(func(params interface{}) (interface{}, error) {
+				*calls = append(*calls, name+" around handler")
+				return next.Handle(params)
+			})
+		},
+		AfterRespond: func(r *http.Request, operation *spec.Operation, params interface{}, result interface{}) {
+			*calls = append(*calls, name+" after respond")
+		},
+	}
+}
+
+func TestUntypedInterceptors(t *testing.T) {
+	doc, api := petstore.NewAPI(t)
+
+	var calls []string
+	api.RegisterOperationInterceptor("getAllPets", recordingInterceptor("operation", &calls))
+	api.RegisterInterceptor(recordingInterceptor("global", &calls))
+	handler := Serve(doc, api)
+
+	request, _ := http.NewRequest("GET", "/api/pets", nil)
+	request.Header.Add("Accept", httpkit.JSONMime)
+	request.SetBasicAuth("admin", "admin")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, []string{
+		"global before bind getAllPets",
+		"operation before bind getAllPets",
+		"global after auth admin",
+		"operation after auth admin",
+		"global around handler",
+		"operation around handler",
+		"global after respond",
+		"operation after respond",
+	}, calls)
+
+	// unauthorized requests don't get past the before bind interceptors
+	calls = nil
+	request, _ = http.NewRequest("GET", "/api/pets", nil)
+	request.Header.Add("Accept", httpkit.JSONMime)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	assert.Equal(t, []string{"global before bind getAllPets", "operation before bind getAllPets"}, calls)
+}
+
+func TestUntypedInterceptorRejects(t *testing.T) {
+	doc, api := petstore.NewAPI(t)
+
+	var handled bool
+	api.RegisterInterceptor(untyped.Interceptor{
+		BeforeBind: func(r *http.Request, operation *spec.Operation) error {
+			return errors.New(http.StatusTooManyRequests, "rate limit exceeded")
+		},
+		AroundHandler: func(operation *spec.Operation, next httpkit.OperationHandler) httpkit.OperationHandler {
+			return httpkit.OperationHandlerFunc(func(params interface{}) (interface{}, error) {
+				handled = true
+				return next.Handle(params)
+			})
+		},
+	})
+	handler := Serve(doc, api)
+
+	request, _ := http.NewRequest("GET", "/api/pets", nil)
+	request.Header.Add("Accept", httpkit.JSONMime)
+	request.SetBasicAuth("admin", "admin")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	assert.False(t, handled)
+}
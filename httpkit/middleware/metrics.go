@@ -0,0 +1,231 @@
+package middleware
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RequestRecord describes a request that was served for an operation
+type RequestRecord struct {
+	OperationID  string
+	Method       string
+	PathTemplate string
+	Status       int
+	Bytes        int64
+	Duration     time.Duration
+}
+
+// MetricsSink receives a record for every request that was served for an operation
+type MetricsSink interface {
+	Record(RequestRecord)
+}
+
+// MetricsSinkFunc turns a function into a metrics sink
+type MetricsSinkFunc func(RequestRecord)
+
+// Record records the request
+func (fn MetricsSinkFunc) Record(record RequestRecord) {
+	fn(record)
+}
+
+// SetMetricsSink records the requests served for the operations of the API with the sink.
+// Requests that don't match an operation aren't recorded.
+func (c *Context) SetMetricsSink(sink MetricsSink) {
+	c.metrics = sink
+}
+
+// LogMetrics creates a metrics sink that writes an access log line for every request
+func LogMetrics(logger *log.Logger) MetricsSink {
+	return MetricsSinkFunc(func(record RequestRecord) {
+		logger.Printf("operation=%s method=%s path=%s status=%d bytes=%d duration=%s",
+			record.OperationID, record.Method, record.PathTemplate, record.Status, record.Bytes, record.Duration)
+	})
+}
+
+// statusRecorder keeps track of the status code and the size of a response
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	if s.status == 0 {
+		s.status = code
+	}
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *statusRecorder) Write(data []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	n, err := s.ResponseWriter.Write(data)
+	s.bytes += int64(n)
+	return n, err
+}
+
+// Flush sends the buffered data to the client, so that streamed responses keep streaming
+func (s *statusRecorder) Flush() {
+	if f, ok := s.ResponseWriter.(http.Flusher); ok {
+		if s.status == 0 {
+			s.status = http.StatusOK
+		}
+		f.Flush()
+	}
+}
+
+// CloseNotify tells when the client goes away, when the wrapped writer can't tell the channel never fires
+func (s *statusRecorder) CloseNotify() <-chan bool {
+	if cn, ok := s.ResponseWriter.(http.CloseNotifier); ok {
+		return cn.CloseNotify()
+	}
+	return make(chan bool)
+}
+
+func newMetrics(ctx *Context, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: rw}
+		next.ServeHTTP(recorder, r)
+
+		route, ok := ctx.RouteInfo(r)
+		if !ok {
+			return
+		}
+		if recorder.status == 0 {
+			recorder.status = http.StatusOK
+		}
+		ctx.metrics.Record(RequestRecord{
+			OperationID:  route.Operation.ID,
+			Method:       r.Method,
+			PathTemplate: route.PathPattern,
+			Status:       recorder.status,
+			Bytes:        recorder.bytes,
+			Duration:     time.Since(start),
+		})
+	})
+}
+
+// OperationMetrics are the aggregated metrics for an operation and status code
+type OperationMetrics struct {
+	OperationID  string
+	Method       string
+	PathTemplate string
+	Status       int
+	Count        int64
+	Bytes        int64
+	Duration     time.Duration
+}
+
+type operationMetricsKey struct {
+	operationID string
+	method      string
+	path        string
+	status      int
+}
+
+// InMemoryMetrics is a metrics sink that aggregates the records in memory
+type InMemoryMetrics struct {
+	lock    sync.Mutex
+	metrics map[operationMetricsKey]*OperationMetrics
+}
+
+// NewInMemoryMetrics creates a new in memory metrics sink
+func NewInMemoryMetrics() *InMemoryMetrics {
+	return &InMemoryMetrics{metrics: make(map[operationMetricsKey]*OperationMetrics)}
+}
+
+// Record aggregates the request with the other requests for the same operation and status code
+func (m *InMemoryMetrics) Record(record RequestRecord) {
+	key := operationMetricsKey{record.OperationID, record.Method, record.PathTemplate, record.Status}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	metrics, ok := m.metrics[key]
+	if !ok {
+		metrics = &OperationMetrics{
+			OperationID:  record.OperationID,
+			Method:       record.Method,
+			PathTemplate: record.PathTemplate,
+			Status:       record.Status,
+		}
+		m.metrics[key] = metrics
+	}
+	metrics.Count++
+	metrics.Bytes += record.Bytes
+	metrics.Duration += record.Duration
+}
+
+// Snapshot returns a copy of the aggregated metrics, sorted by operation id and status code
+func (m *InMemoryMetrics) Snapshot() []OperationMetrics {
+	m.lock.Lock()
+	result := make([]OperationMetrics, 0, len(m.metrics))
+	for _, metrics := range m.metrics {
+		result = append(result, *metrics)
+	}
+	m.lock.Unlock()
+
+	sort.Sort(operationMetricsSlice(result))
+	return result
+}
+
+type operationMetricsSlice []OperationMetrics
+
+func (o operationMetricsSlice) Len() int      { return len(o) }
+func (o operationMetricsSlice) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
+func (o operationMetricsSlice) Less(i, j int) bool {
+	if o[i].OperationID != o[j].OperationID {
+		return o[i].OperationID < o[j].OperationID
+	}
+	if o[i].Method != o[j].Method {
+		return o[i].Method < o[j].Method
+	}
+	if o[i].PathTemplate != o[j].PathTemplate {
+		return o[i].PathTemplate < o[j].PathTemplate
+	}
+	return o[i].Status < o[j].Status
+}
+
+// MetricsHandler serves the in memory metrics in the prometheus text exposition format
+func MetricsHandler(metrics *InMemoryMetrics) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		snapshot := metrics.Snapshot()
+
+		rw.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		rw.WriteHeader(http.StatusOK)
+
+		fmt.Fprintln(rw, "# HELP swagger_requests_total Number of requests served per operation and status code.")
+		fmt.Fprintln(rw, "# TYPE swagger_requests_total counter")
+		for _, m := range snapshot {
+			fmt.Fprintf(rw, "swagger_requests_total{%s} %d\n", metricsLabels(m), m.Count)
+		}
+
+		fmt.Fprintln(rw, "# HELP swagger_response_bytes_total Number of bytes written per operation and status code.")
+		fmt.Fprintln(rw, "# TYPE swagger_response_bytes_total counter")
+		for _, m := range snapshot {
+			fmt.Fprintf(rw, "swagger_response_bytes_total{%s} %d\n", metricsLabels(m), m.Bytes)
+		}
+
+		fmt.Fprintln(rw, "# HELP swagger_request_duration_seconds Time spent serving the requests per operation and status code.")
+		fmt.Fprintln(rw, "# TYPE swagger_request_duration_seconds summary")
+		for _, m := range snapshot {
+			labels := metricsLabels(m)
+			fmt.Fprintf(rw, "swagger_request_duration_seconds_sum{%s} %g\n", labels, m.Duration.Seconds())
+			fmt.Fprintf(rw, "swagger_request_duration_seconds_count{%s} %d\n", labels, m.Count)
+		}
+	})
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func metricsLabels(m OperationMetrics) string {
+	return fmt.Sprintf(`operation_id="%s",method="%s",path="%s",status="%d"`,
+		labelEscaper.Replace(m.OperationID), labelEscaper.Replace(m.Method), labelEscaper.Replace(m.PathTemplate), m.Status)
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	ctx := newTasksContext(t, map[string]interface{}{"id": 1})
+	metrics := NewInMemoryMetrics()
+	ctx.SetMetricsSink(metrics)
+	handler := ctx.APIHandler()
+
+	for _, pth := range []string{"/tasks/1", "/tasks/2", "/tasks/nan", "/nope"} {
+		recorder := httptest.NewRecorder()
+		request, _ := http.NewRequest("GET", pth, nil)
+		request.Header.Set(httpkit.HeaderAccept, httpkit.JSONMime)
+		handler.ServeHTTP(recorder, request)
+	}
+
+	snapshot := metrics.Snapshot()
+	if assert.Len(t, snapshot, 2) {
+		assert.Equal(t, "getTask", snapshot[0].OperationID)
+		assert.Equal(t, "GET", snapshot[0].Method)
+		assert.Equal(t, "/tasks/{id}", snapshot[0].PathTemplate)
+		assert.Equal(t, http.StatusOK, snapshot[0].Status)
+		assert.EqualValues(t, 2, snapshot[0].Count)
+		assert.EqualValues(t, len(`{"id":1}`+"\n")*2, snapshot[0].Bytes)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, snapshot[1].Status)
+		assert.EqualValues(t, 1, snapshot[1].Count)
+	}
+}
+
+func TestMetricsHandler(t *testing.T) {
+	metrics := NewInMemoryMetrics()
+	metrics.Record(RequestRecord{OperationID: "getTask", Method: "GET", PathTemplate: "/tasks/{id}", Status: 200, Bytes: 10, Duration: time.Second})
+	metrics.Record(RequestRecord{OperationID: "getTask", Method: "GET", PathTemplate: "/tasks/{id}", Status: 200, Bytes: 5, Duration: time.Second / 2})
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/metrics", nil)
+	MetricsHandler(metrics).ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	labels := `{operation_id="getTask",method="GET",path="/tasks/{id}",status="200"}`
+	body := recorder.Body.String()
+	assert.Contains(t, body, "# TYPE swagger_requests_total counter\n")
+	assert.Contains(t, body, "swagger_requests_total"+labels+" 2\n")
+	assert.Contains(t, body, "swagger_response_bytes_total"+labels+" 15\n")
+	assert.Contains(t, body, "swagger_request_duration_seconds_sum"+labels+" 1.5\n")
+	assert.Contains(t, body, "swagger_request_duration_seconds_count"+labels+" 2\n")
+}
+
+func TestLogMetrics(t *testing.T) {
+	var buf bytes.Buffer
+	LogMetrics(log.New(&buf, "", 0)).Record(RequestRecord{OperationID: "getTask", Method: "GET", PathTemplate: "/tasks/{id}", Status: 200, Bytes: 10, Duration: time.Millisecond})
+	assert.Equal(t, "operation=getTask method=GET path=/tasks/{id} status=200 bytes=10 duration=1ms", strings.TrimSpace(buf.String()))
+}
@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"net/http"
+	"path"
+	"strings"
+)
+
+// specPaths are the paths the spec is served on
+var specPaths = []string{"/swagger.json", "/swagger.yaml", "/swagger"}
+
+// ServeMuxer is implemented by routers that register a handler for a url pattern, like http.ServeMux
+type ServeMuxer interface {
+	Handle(pattern string, handler http.Handler)
+}
+
+// MountServeMux mounts the API handler on a http.ServeMux style router.
+//
+// Those routers only match static paths and path prefixes, so the static part of every operation path
+// is registered with the mux and the swagger router matches the operation within the API handler.
+// The swagger spec is registered at /swagger.json, /swagger.yaml and /swagger
+func (c *Context) MountServeMux(mux ServeMuxer) {
+	handler := c.APIHandler()
+	registered := make(map[string]bool)
+	for _, pattern := range specPaths {
+		registered[pattern] = true
+		mux.Handle(pattern, handler)
+	}
+
+	for _, paths := range c.spec.Operations() {
+		for pth := range paths {
+			pattern := path.Join("/", c.spec.BasePath(), pth)
+			if idx := strings.Index(pattern, "{"); idx >= 0 {
+				// register the subtree below the last static path segment
+				pattern = pattern[:strings.LastIndex(pattern[:idx], "/")+1]
+			}
+			if !registered[pattern] {
+				registered[pattern] = true
+				mux.Handle(pattern, handler)
+			}
+		}
+	}
+}
+
+// RouteRegistrar registers a handler for a method and a path template with a router, like gorilla/mux.
+// The path template uses the swagger syntax for path params, for example /api/pets/{id}
+type RouteRegistrar func(method, pathTemplate string, handler http.Handler)
+
+// PathVarsFunc returns the path params a router extracted from the request, like mux.Vars
+type PathVarsFunc func(*http.Request) map[string]string
+
+// MountPathTemplates registers every operation of the API with a router that matches path templates.
+//
+// The router matches the operation and the path params are read from the request with the vars func,
+// the swagger router isn't used in this case.
+// The swagger spec is registered at /swagger.json, /swagger.yaml and /swagger
+func (c *Context) MountPathTemplates(register RouteRegistrar, vars PathVarsFunc) {
+	for _, pth := range specPaths {
+		register("GET", pth, specMiddleware(c, nil))
+	}
+
+	handler := c.operationHandler()
+	for method, paths := range c.spec.Operations() {
+		for pth, operation := range paths {
+			if entry, ok := newRouteEntry(c.spec, c.api, method, pth, operation); ok {
+				register(strings.ToUpper(method), path.Join("/", c.spec.BasePath(), pth), newMatchedRouteHandler(entry, vars, handler))
+			}
+		}
+	}
+}
+
+// newMatchedRouteHandler puts the route matched by an external router in the request context
+func newMatchedRouteHandler(entry *routeEntry, vars PathVarsFunc, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		r = withRequestState(r)
+
+		var params RouteParams
+		for name, value := range vars(r) {
+			params = append(params, RouteParam{Name: name, Value: value})
+		}
+		requestStateFrom(r).route = &MatchedRoute{routeEntry: *entry, Params: params}
+		next.ServeHTTP(rw, r)
+	})
+}
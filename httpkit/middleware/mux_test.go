@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/internal/testing/petstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMountServeMux(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	mux := http.NewServeMux()
+	NewContext(spec, api, nil).MountServeMux(mux)
+	mux.HandleFunc("/other", terminator)
+
+	for _, pth := range []string{"/api/pets", "/api/pets/1"} {
+		request, _ := http.NewRequest("GET", pth, nil)
+		request.SetBasicAuth("admin", "admin")
+		request.Header.Add("Accept", httpkit.JSONMime)
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code, pth)
+	}
+
+	request, _ := http.NewRequest("GET", "/swagger.json", nil)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	request, _ = http.NewRequest("GET", "/other", nil)
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	request, _ = http.NewRequest("GET", "/api/nopets", nil)
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestMountPathTemplates(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	context := NewContext(spec, api, nil)
+
+	routes := make(map[string]http.Handler)
+	register := func(method, pathTemplate string, handler http.Handler) {
+		routes[method+" "+pathTemplate] = handler
+	}
+	vars := func(r *http.Request) map[string]string {
+		return map[string]string{"id": "1"}
+	}
+	context.MountPathTemplates(register, vars)
+
+	assert.Len(t, routes, 7)
+	for _, k := range []string{"GET /swagger.json", "GET /swagger.yaml", "GET /swagger", "GET /api/pets", "POST /api/pets", "GET /api/pets/{id}", "DELETE /api/pets/{id}"} {
+		assert.Contains(t, routes, k)
+	}
+
+	handler := routes["GET /api/pets/{id}"]
+	if assert.NotNil(t, handler) {
+		request, _ := http.NewRequest("GET", "/api/pets/1", nil)
+		request.SetBasicAuth("admin", "admin")
+		request.Header.Add("Accept", httpkit.JSONMime)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	}
+
+	var matched *MatchedRoute
+	entry, ok := newRouteEntry(spec, context.api, "GET", "/pets/{id}", spec.AllPaths()["/pets/{id}"].Get)
+	if assert.True(t, ok) {
+		handler := newMatchedRouteHandler(entry, vars, http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			matched, _ = context.RouteInfo(r)
+		}))
+		request, _ := http.NewRequest("GET", "/api/pets/1", nil)
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+
+		if assert.NotNil(t, matched) {
+			assert.Equal(t, "getPetById", matched.Operation.ID)
+			assert.Equal(t, "1", matched.Params.Get("id"))
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"sort"
+
+	"github.com/go-swagger/go-swagger/httpkit"
+)
+
+// consumerFor gets the consumer for the media type of a request body,
+// when there is no consumer for the exact media type the one for the best matching media type is used.
+// This way application/vnd.acme.v2+json is read by the consumer for application/json.
+func consumerFor(consumers map[string]httpkit.Consumer, mediaType string) httpkit.Consumer {
+	if consumer, ok := consumers[mediaType]; ok {
+		return consumer
+	}
+	mediaTypes := make([]string, 0, len(consumers))
+	for k := range consumers {
+		mediaTypes = append(mediaTypes, k)
+	}
+	sort.Strings(mediaTypes)
+	if mt, ok := httpkit.LookupMediaType(mediaTypes, mediaType); ok {
+		return consumers[mt]
+	}
+	return nil
+}
+
+// producerFor gets the producer for the negotiated response format,
+// when there is no producer for the exact format the one for the best matching media type is used
+func producerFor(producers map[string]httpkit.Producer, format string) (httpkit.Producer, bool) {
+	if producer, ok := producers[format]; ok {
+		return producer, true
+	}
+	mediaTypes := make([]string, 0, len(producers))
+	for k := range producers {
+		mediaTypes = append(mediaTypes, k)
+	}
+	sort.Strings(mediaTypes)
+	if mt, ok := httpkit.LookupMediaType(mediaTypes, format); ok {
+		return producers[mt], true
+	}
+	return nil, false
+}
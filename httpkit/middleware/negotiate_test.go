@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/httpkit/middleware/untyped"
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+const vendorSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "vendor media types", "version": "2.0.0"},
+  "consumes": ["application/vnd.acme.v2+json"],
+  "produces": ["application/vnd.acme.v2+json", "text/plain; charset=utf-8"],
+  "paths": {
+    "/tasks": {
+      "post": {
+        "operationId": "createTask",
+        "parameters": [{"name": "body", "in": "body", "required": true, "schema": {"type": "object"}}],
+        "responses": {"200": {"description": "the created task", "schema": {"type": "object"}}}
+      }
+    }
+  }
+}`
+
+func newVendorContext(t *testing.T) *Context {
+	doc, err := spec.New(json.RawMessage(vendorSpec), "")
+	assert.NoError(t, err)
+
+	api := untyped.NewAPI(doc)
+	api.RegisterProducer("text/plain", httpkit.JSONProducer())
+	api.RegisterOperation("createTask", httpkit.OperationHandlerFunc(func(params interface{}) (interface{}, error) {
+		return params.(map[string]interface{})["body"], nil
+	}))
+	assert.NoError(t, api.Validate())
+	return NewContext(doc, api, nil)
+}
+
+func TestVendorMediaTypeWithStockJSON(t *testing.T) {
+	ctx := newVendorContext(t)
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/tasks", bytes.NewBufferString(`{"name":"write tests"}`))
+	request.Header.Set(httpkit.HeaderContentType, "application/vnd.acme.v2+json; charset=utf-8")
+	request.Header.Set(httpkit.HeaderAccept, "application/json, text/plain;q=0.5")
+	ctx.APIHandler().ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/vnd.acme.v2+json", recorder.Header().Get(httpkit.HeaderContentType))
+	assert.JSONEq(t, `{"name":"write tests"}`, recorder.Body.String())
+}
+
+func TestNegotiateWildcardAndCharset(t *testing.T) {
+	ctx := newVendorContext(t)
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/tasks", bytes.NewBufferString(`{"name":"write tests"}`))
+	request.Header.Set(httpkit.HeaderContentType, "application/vnd.acme.v2+json")
+	request.Header.Set(httpkit.HeaderAccept, "text/*")
+	ctx.APIHandler().ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/plain; charset=utf-8", recorder.Header().Get(httpkit.HeaderContentType))
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/tasks", bytes.NewBufferString(`{"name":"write tests"}`))
+	request.Header.Set(httpkit.HeaderContentType, "application/xml")
+	request.Header.Set(httpkit.HeaderAccept, "application/json")
+	ctx.APIHandler().ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/tasks", bytes.NewBufferString(`{"name":"write tests"}`))
+	request.Header.Set(httpkit.HeaderContentType, "application/vnd.acme.v2+json")
+	request.Header.Set(httpkit.HeaderAccept, "image/png")
+	ctx.APIHandler().ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusNotAcceptable, recorder.Code)
+}
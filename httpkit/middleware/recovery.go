@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/go-swagger/go-swagger/errors"
+)
+
+// PanicCallback is called with the recovered value and the stack trace when serving a request panics
+type PanicCallback func(r *http.Request, recovered interface{}, stack []byte)
+
+// SetPanicCallback registers a callback for the panics that get recovered while serving a request,
+// when no callback is registered the panic and its stack trace are logged
+func (c *Context) SetPanicCallback(callback PanicCallback) {
+	c.panicCallback = callback
+}
+
+// newRecovery turns a panic into an internal server error that is served with the ServeErrorFor hook of the API
+func newRecovery(ctx *Context, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		recorder := &statusRecorder{ResponseWriter: rw}
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+
+			stack := debug.Stack()
+			if ctx.panicCallback != nil {
+				ctx.panicCallback(r, recovered, stack)
+			} else {
+				log.Printf("recovered from panic while serving %s %s: %v\n%s", r.Method, r.URL.Path, recovered, stack)
+			}
+
+			if recorder.status != 0 {
+				// the response is already on its way, it's too late to replace it with an error
+				return
+			}
+
+			// the recovered value can tell more about the server than the client should know,
+			// it only goes to the panic callback or the log
+			var operationID string
+			if route, ok := ctx.RouteInfo(r); ok && route.Operation != nil {
+				operationID = route.Operation.ID
+			}
+			ctx.api.ServeErrorFor(operationID)(rw, r, errors.New(http.StatusInternalServerError, "internal server error"))
+		}()
+		next.ServeHTTP(recorder, r)
+	})
+}
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/errors"
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/httpkit/middleware/untyped"
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPanickingContext(t *testing.T, producer httpkit.Producer) *Context {
+	doc, err := spec.New(json.RawMessage(tasksSpec), "")
+	assert.NoError(t, err)
+
+	api := untyped.NewAPI(doc)
+	api.RegisterProducer(httpkit.JSONMime, producer)
+	api.RegisterOperation("getTask", httpkit.OperationHandlerFunc(func(params interface{}) (interface{}, error) {
+		return map[string]interface{}{"id": 1}, nil
+	}))
+	api.RegisterOperation("listTasks", httpkit.OperationHandlerFunc(func(params interface{}) (interface{}, error) {
+		panic("list tasks is broken")
+	}))
+	return NewContext(doc, api, nil)
+}
+
+func TestRecoveryFromHandler(t *testing.T) {
+	ctx := newPanickingContext(t, httpkit.JSONProducer())
+
+	var recovered interface{}
+	var stack []byte
+	ctx.SetPanicCallback(func(r *http.Request, rec interface{}, st []byte) {
+		recovered = rec
+		stack = st
+	})
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/tasks", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.JSONMime)
+	ctx.APIHandler().ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Equal(t, httpkit.JSONMime, recorder.Header().Get(httpkit.HeaderContentType))
+	assert.JSONEq(t, `{"code":500,"message":"internal server error"}`, recorder.Body.String())
+	assert.Equal(t, "list tasks is broken", recovered)
+	assert.NotEmpty(t, stack)
+}
+
+func TestRecoveryFromProducer(t *testing.T) {
+	ctx := newPanickingContext(t, httpkit.ProducerFunc(func(w io.Writer, data interface{}) error {
+		return errors.New(http.StatusInternalServerError, "can't produce")
+	}))
+
+	var recovered interface{}
+	ctx.SetPanicCallback(func(r *http.Request, rec interface{}, st []byte) {
+		recovered = rec
+	})
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/tasks/1", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.JSONMime)
+	ctx.APIHandler().ServeHTTP(recorder, request)
+
+	// the status code was sent before the producer failed
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Empty(t, recorder.Body.String())
+	assert.NotNil(t, recovered)
+}
+
+func TestStatusRecorderStreaming(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	var rw http.ResponseWriter = &statusRecorder{ResponseWriter: recorder}
+
+	// streamed responses need to flush through the recorder
+	flusher, ok := rw.(http.Flusher)
+	if assert.True(t, ok) {
+		rw.Write([]byte("chunk"))
+		flusher.Flush()
+		assert.True(t, recorder.Flushed)
+		assert.Equal(t, "chunk", recorder.Body.String())
+	}
+
+	// the recorder doesn't notify, so neither does the status recorder
+	notifier, ok := rw.(http.CloseNotifier)
+	if assert.True(t, ok) {
+		select {
+		case <-notifier.CloseNotify():
+			t.Error("expected no close notification")
+		default:
+		}
+	}
+}
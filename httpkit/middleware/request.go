@@ -49,13 +49,18 @@ func (o *untypedRequestBinder) Bind(request *http.Request, routeParams RoutePara
 
 		if isMap {
 			tpe := binder.Type()
-			if tpe == nil {
-				if param.Schema.Type.Contains("array") {
+			if tpe == nil && param.Schema != nil {
+				switch {
+				case param.Schema.Type.Contains("array"):
 					tpe = reflect.TypeOf([]interface{}{})
-				} else {
-					tpe = reflect.TypeOf(map[string]interface{}{})
+				case len(param.Schema.Type) == 1:
+					// a body of a primitive type, like the text of a text/plain request
+					tpe = binder.typeForSchema(param.Schema.Type[0], param.Schema.Format, nil)
 				}
 			}
+			if tpe == nil {
+				tpe = reflect.TypeOf(map[string]interface{}{})
+			}
 			target = reflect.Indirect(reflect.New(tpe))
 
 		}
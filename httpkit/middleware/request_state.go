@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	stdcontext "context"
+	"net/http"
+)
+
+type contextKey int8
+
+const (
+	_ contextKey = iota
+	ctxRequestState
+)
+
+// requestState carries the values that get resolved for a request while it's being served.
+// It's stored in the context of the request, so it travels along with the request and the copies made of it.
+type requestState struct {
+	contentType    *contentTypeValue
+	responseFormat string
+	route          *MatchedRoute
+	bound          *validation
+	principal      interface{}
+}
+
+// withRequestState returns the request with an empty request state,
+// a request that already has a state is returned as is
+func withRequestState(r *http.Request) *http.Request {
+	if requestStateFrom(r) != nil {
+		return r
+	}
+	return r.WithContext(stdcontext.WithValue(r.Context(), ctxRequestState, new(requestState)))
+}
+
+// requestStateFrom gets the request state, when the request wasn't served by the swagger router this is nil
+func requestStateFrom(r *http.Request) *requestState {
+	if r == nil {
+		return nil
+	}
+	state, _ := r.Context().Value(ctxRequestState).(*requestState)
+	return state
+}
+
+// MatchedRouteFrom gets the route that was matched for the request
+func MatchedRouteFrom(r *http.Request) *MatchedRoute {
+	if state := requestStateFrom(r); state != nil {
+		return state.route
+	}
+	return nil
+}
+
+// SecurityPrincipalFrom gets the principal that was authorized for the request
+func SecurityPrincipalFrom(r *http.Request) interface{} {
+	if state := requestStateFrom(r); state != nil {
+		return state.principal
+	}
+	return nil
+}
+
+// BoundParamsFrom gets the params that were bound from the request by BindAndValidate
+func BoundParamsFrom(r *http.Request) interface{} {
+	if state := requestStateFrom(r); state != nil && state.bound != nil {
+		return state.bound.bound
+	}
+	return nil
+}
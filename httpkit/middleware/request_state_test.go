@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/internal/testing/petstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestState(t *testing.T) {
+	request, _ := http.NewRequest("GET", "/pets", nil)
+	assert.Nil(t, requestStateFrom(request))
+	assert.Nil(t, MatchedRouteFrom(request))
+	assert.Nil(t, SecurityPrincipalFrom(request))
+	assert.Nil(t, BoundParamsFrom(request))
+
+	request = withRequestState(request)
+	state := requestStateFrom(request)
+	if assert.NotNil(t, state) {
+		assert.Equal(t, request, withRequestState(request))
+
+		// copies of the request share the state
+		state.principal = "admin"
+		assert.Equal(t, "admin", SecurityPrincipalFrom(request.WithContext(request.Context())))
+	}
+}
+
+func TestRequestStateFromRouter(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	context := NewContext(spec, api, nil)
+
+	var matched *MatchedRoute
+	var principal interface{}
+	mw := newRouter(context, http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		route, _ := context.RouteInfo(r)
+		context.Authorize(r, route)
+
+		matched = MatchedRouteFrom(r)
+		principal = SecurityPrincipalFrom(r)
+		rw.WriteHeader(http.StatusOK)
+	}))
+
+	request, _ := http.NewRequest("GET", "/api/pets", nil)
+	request.SetBasicAuth("admin", "admin")
+	recorder := httptest.NewRecorder()
+	mw.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	if assert.NotNil(t, matched) {
+		assert.Equal(t, "getAllPets", matched.Operation.ID)
+	}
+	assert.Equal(t, "admin", principal)
+	assert.Nil(t, requestStateFrom(request))
+}
@@ -0,0 +1,175 @@
+package middleware
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+
+	"github.com/go-swagger/go-swagger/errors"
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/internal/validate"
+	"github.com/go-swagger/go-swagger/spec"
+)
+
+// ResponseValidation represents the way responses of operation handlers get validated against the spec
+type ResponseValidation uint8
+
+const (
+	// NoResponseValidation doesn't validate responses, this is the default
+	NoResponseValidation ResponseValidation = iota
+	// LogResponseValidation logs the responses that don't match the spec and sends them as they are
+	LogResponseValidation
+	// StrictResponseValidation replaces the responses that don't match the spec with an internal server error,
+	// this is meant to be used in development and contract tests
+	StrictResponseValidation
+)
+
+// SetResponseValidation enables validation of the responses written by the operation handlers.
+// The status code, content type, headers and body are checked against the responses declared for the operation,
+// the body is only checked against the schema of the response when it's JSON.
+func (c *Context) SetResponseValidation(mode ResponseValidation) {
+	c.responseValidation = mode
+}
+
+// responseRecorder buffers a response so it can be validated before it's sent to the client
+type responseRecorder struct {
+	header      http.Header
+	code        int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{header: make(http.Header), code: http.StatusOK}
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(code int) {
+	if r.wroteHeader {
+		return
+	}
+	r.code = code
+	r.wroteHeader = true
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+	return r.body.Write(data)
+}
+
+func (r *responseRecorder) flush(rw http.ResponseWriter) {
+	for k, v := range r.header {
+		rw.Header()[k] = v
+	}
+	rw.WriteHeader(r.code)
+	rw.Write(r.body.Bytes())
+}
+
+func newResponseValidation(ctx *Context, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		recorder := newResponseRecorder()
+		next.ServeHTTP(recorder, r)
+
+		route, _ := ctx.RouteInfo(r)
+		if res := validateResponse(ctx, r, route, recorder); len(res) > 0 {
+			err := errors.CompositeValidationError(res...)
+			log.Printf("response of operation %s doesn't match the spec: %v", route.Operation.ID, err)
+
+			if ctx.responseValidation == StrictResponseValidation {
+				ctx.Respond(rw, r, route.Produces, route, errors.New(http.StatusInternalServerError, "response of operation %s doesn't match the spec: %v", route.Operation.ID, err))
+				return
+			}
+		}
+
+		recorder.flush(rw)
+	})
+}
+
+func validateResponse(ctx *Context, request *http.Request, route *MatchedRoute, recorder *responseRecorder) []error {
+	if route == nil || route.Operation == nil || route.Operation.Responses == nil {
+		return nil
+	}
+	responses := route.Operation.Responses
+
+	response, ok := responses.StatusCodeResponses[recorder.code]
+	if !ok {
+		if responses.Default == nil {
+			return []error{errors.New(http.StatusInternalServerError, "response status %d is not defined for operation %s", recorder.code, route.Operation.ID)}
+		}
+		response = *responses.Default
+	}
+
+	var res []error
+	if len(response.Headers) > 0 {
+		if err := validateResponseHeaders(ctx, response.Headers, recorder.header); err != nil {
+			res = append(res, err)
+		}
+	}
+
+	if recorder.body.Len() == 0 || request.Method == "HEAD" {
+		return res
+	}
+
+	ct, _, err := httpkit.ContentType(recorder.header)
+	if err != nil {
+		return append(res, err)
+	}
+	if err := validateContentType(route.Produces, ct); err != nil {
+		return append(res, err)
+	}
+
+	// only JSON bodies are checked against the schema, the other consumers
+	// like the XML one can't decode a body without a type to decode into
+	if response.Schema != nil && httpkit.MatchMediaType(httpkit.JSONMime, ct) {
+		consumer, ok := ctx.api.ConsumersFor([]string{ct})[ct]
+		if !ok {
+			return res
+		}
+
+		var data interface{}
+		if err := consumer.Consume(bytes.NewReader(recorder.body.Bytes()), &data); err != nil {
+			return append(res, errors.NewParseError("body", "response", "", err))
+		}
+
+		validator := validate.NewSchemaValidator(response.Schema, ctx.spec.Spec(), "body", ctx.api.Formats())
+		if result := validator.Validate(data); result != nil && result.HasErrors() {
+			res = append(res, result.AsError())
+		}
+	}
+	return res
+}
+
+// validateResponseHeaders reads and validates the response headers like header parameters of a request,
+// the headers declared for a response are expected to be present
+func validateResponseHeaders(ctx *Context, headers map[string]spec.Header, values http.Header) error {
+	params := make(map[string]spec.Parameter, len(headers))
+	for name, header := range headers {
+		param := spec.HeaderParam(name)
+		param.Type = header.Type
+		param.Format = header.Format
+		param.Items = header.Items
+		param.CollectionFormat = header.CollectionFormat
+		param.Default = header.Default
+		param.Maximum = header.Maximum
+		param.ExclusiveMaximum = header.ExclusiveMaximum
+		param.Minimum = header.Minimum
+		param.ExclusiveMinimum = header.ExclusiveMinimum
+		param.MaxLength = header.MaxLength
+		param.MinLength = header.MinLength
+		param.Pattern = header.Pattern
+		param.MaxItems = header.MaxItems
+		param.MinItems = header.MinItems
+		param.UniqueItems = header.UniqueItems
+		param.MultipleOf = header.MultipleOf
+		param.Enum = header.Enum
+		params[name] = *param
+	}
+
+	binder := newUntypedRequestBinder(params, ctx.spec.Spec(), ctx.api.Formats())
+	return binder.Bind(&http.Request{Header: values}, nil, nil, make(map[string]interface{}))
+}
@@ -0,0 +1,206 @@
+package middleware
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/httpkit/middleware/untyped"
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+const tasksSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "tasks", "version": "1.0.0"},
+  "consumes": ["application/json"],
+  "produces": ["application/json"],
+  "paths": {
+    "/tasks": {
+      "get": {
+        "operationId": "listTasks",
+        "responses": {
+          "200": {
+            "description": "the tasks",
+            "headers": {"X-Rate-Limit": {"type": "integer", "format": "int32", "maximum": 100}},
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "object",
+                "required": ["id"],
+                "properties": {"id": {"type": "integer", "format": "int64"}, "content": {"type": "string"}}
+              }
+            }
+          }
+        }
+      }
+    },
+    "/tasks/{id}": {
+      "get": {
+        "operationId": "getTask",
+        "parameters": [{"name": "id", "in": "path", "required": true, "type": "integer", "format": "int64"}],
+        "responses": {
+          "200": {
+            "description": "the task",
+            "schema": {
+              "type": "object",
+              "required": ["id"],
+              "properties": {"id": {"type": "integer", "format": "int64"}, "content": {"type": "string"}}
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+func newTasksContext(t *testing.T, result interface{}) *Context {
+	doc, err := spec.New(json.RawMessage(tasksSpec), "")
+	assert.NoError(t, err)
+
+	api := untyped.NewAPI(doc)
+	api.RegisterConsumer(httpkit.JSONMime, httpkit.JSONConsumer())
+	api.RegisterProducer(httpkit.JSONMime, httpkit.JSONProducer())
+	api.RegisterOperation("getTask", httpkit.OperationHandlerFunc(func(params interface{}) (interface{}, error) {
+		return result, nil
+	}))
+	return NewContext(doc, api, nil)
+}
+
+func TestResponseValidationDisabled(t *testing.T) {
+	ctx := newTasksContext(t, map[string]interface{}{"content": "no id"})
+	handler := ctx.APIHandler()
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/tasks/1", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.JSONMime)
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+}
+
+func TestResponseValidationLog(t *testing.T) {
+	ctx := newTasksContext(t, map[string]interface{}{"content": "no id"})
+	ctx.SetResponseValidation(LogResponseValidation)
+	handler := ctx.APIHandler()
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/tasks/1", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.JSONMime)
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, httpkit.JSONMime, recorder.Header().Get(httpkit.HeaderContentType))
+	assert.JSONEq(t, `{"content":"no id"}`, recorder.Body.String())
+}
+
+func TestResponseValidationStrict(t *testing.T) {
+	ctx := newTasksContext(t, map[string]interface{}{"content": "no id"})
+	ctx.SetResponseValidation(StrictResponseValidation)
+	handler := ctx.APIHandler()
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/tasks/1", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.JSONMime)
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 500, recorder.Code)
+
+	ctx = newTasksContext(t, map[string]interface{}{"id": 1, "content": "with id"})
+	ctx.SetResponseValidation(StrictResponseValidation)
+	handler = ctx.APIHandler()
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/tasks/1", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.JSONMime)
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.JSONEq(t, `{"id":1,"content":"with id"}`, recorder.Body.String())
+}
+
+func TestValidateResponse(t *testing.T) {
+	ctx := newTasksContext(t, nil)
+	ctx.router = DefaultRouter(ctx.spec, ctx.api)
+	listTasks, _ := ctx.spec.OperationForName("listTasks")
+	route := &MatchedRoute{routeEntry: routeEntry{Operation: listTasks, Produces: []string{httpkit.JSONMime}}}
+	request, _ := http.NewRequest("GET", "/tasks", nil)
+
+	recorder := newResponseRecorder()
+	recorder.Header().Set(httpkit.HeaderContentType, httpkit.JSONMime)
+	recorder.Header().Set("X-Rate-Limit", "10")
+	recorder.Write([]byte(`[{"id":1,"content":"do the dishes"}]`))
+	assert.Empty(t, validateResponse(ctx, request, route, recorder))
+
+	// undeclared status code
+	recorder = newResponseRecorder()
+	recorder.WriteHeader(http.StatusNotFound)
+	assert.Len(t, validateResponse(ctx, request, route, recorder), 1)
+
+	// missing and invalid headers
+	recorder = newResponseRecorder()
+	assert.Len(t, validateResponse(ctx, request, route, recorder), 1)
+	recorder.Header().Set("X-Rate-Limit", "1000")
+	assert.Len(t, validateResponse(ctx, request, route, recorder), 1)
+
+	// unsupported content type
+	recorder = newResponseRecorder()
+	recorder.Header().Set(httpkit.HeaderContentType, "text/plain")
+	recorder.Header().Set("X-Rate-Limit", "10")
+	recorder.Write([]byte(`do the dishes`))
+	assert.Len(t, validateResponse(ctx, request, route, recorder), 1)
+
+	// invalid body
+	recorder = newResponseRecorder()
+	recorder.Header().Set(httpkit.HeaderContentType, httpkit.JSONMime)
+	recorder.Header().Set("X-Rate-Limit", "10")
+	recorder.Write([]byte(`[{"content":"do the dishes"}]`))
+	assert.Len(t, validateResponse(ctx, request, route, recorder), 1)
+}
+
+type xmlTask struct {
+	XMLName xml.Name `xml:"task"`
+	ID      int64    `xml:"id"`
+	Content string   `xml:"content"`
+}
+
+func TestResponseValidationXML(t *testing.T) {
+	doc, err := spec.New(json.RawMessage(strings.Replace(tasksSpec, `"produces": ["application/json"]`, `"produces": ["application/json", "application/xml"]`, 1)), "")
+	assert.NoError(t, err)
+
+	api := untyped.NewAPI(doc)
+	api.RegisterConsumer(httpkit.JSONMime, httpkit.JSONConsumer())
+	api.RegisterProducer(httpkit.JSONMime, httpkit.JSONProducer())
+	api.RegisterConsumer(httpkit.XMLMime, httpkit.XMLConsumer())
+	api.RegisterProducer(httpkit.XMLMime, httpkit.XMLProducer())
+	api.RegisterOperation("getTask", httpkit.OperationHandlerFunc(func(params interface{}) (interface{}, error) {
+		return &xmlTask{ID: 1, Content: "with id"}, nil
+	}))
+	ctx := NewContext(doc, api, nil)
+	ctx.SetResponseValidation(StrictResponseValidation)
+	handler := ctx.APIHandler()
+
+	// the XML body isn't checked against the schema
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/tasks/1", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.XMLMime)
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, httpkit.XMLMime, recorder.Header().Get(httpkit.HeaderContentType))
+	assert.Equal(t, `<task><id>1</id><content>with id</content></task>`, recorder.Body.String())
+
+	// the headers of an XML response are still checked
+	ctx.router = DefaultRouter(ctx.spec, ctx.api)
+	listTasks, _ := ctx.spec.OperationForName("listTasks")
+	route := &MatchedRoute{routeEntry: routeEntry{Operation: listTasks, Produces: []string{httpkit.JSONMime, httpkit.XMLMime}}}
+	request, _ = http.NewRequest("GET", "/tasks", nil)
+
+	buffered := newResponseRecorder()
+	buffered.Header().Set(httpkit.HeaderContentType, httpkit.XMLMime)
+	buffered.Header().Set("X-Rate-Limit", "10")
+	buffered.Write([]byte(`<tasks><task><id>1</id></task></tasks>`))
+	assert.Empty(t, validateResponse(ctx, request, route, buffered))
+
+	buffered.Header().Set("X-Rate-Limit", "1000")
+	assert.Len(t, validateResponse(ctx, request, route, buffered), 1)
+}
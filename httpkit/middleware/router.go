@@ -3,13 +3,13 @@ package middleware
 import (
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/go-swagger/go-swagger/errors"
 	"github.com/go-swagger/go-swagger/httpkit"
 	"github.com/go-swagger/go-swagger/spec"
 	"github.com/go-swagger/go-swagger/strfmt"
-	"github.com/gorilla/context"
 	"github.com/naoina/denco"
 )
 
@@ -41,7 +41,14 @@ func newRouter(ctx *Context, next http.Handler) http.Handler {
 	isRoot := ctx.spec.BasePath() == "" || ctx.spec.BasePath() == "/"
 
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-		defer context.Clear(r)
+		r = withRequestState(r)
+		if r.Method == "HEAD" {
+			rw = &headResponseWriter{rw}
+		}
+		if ctx.cors != nil {
+			ctx.writeCORSHeaders(rw, r)
+		}
+
 		// use context to lookup routes
 		if isRoot {
 			if _, ok := ctx.RouteInfo(r); ok {
@@ -57,8 +64,20 @@ func newRouter(ctx *Context, next http.Handler) http.Handler {
 				}
 			}
 		}
-		// Not found, check if it exists in the other methods first
+		// Not found, answer preflight requests when cors is enabled
+		if ctx.cors != nil && ctx.servePreflight(rw, r) {
+			return
+		}
+
+		// check if it exists in the other methods first
 		if others := ctx.AllowedMethods(r); len(others) > 0 {
+			if r.Method == "OPTIONS" {
+				allowed := append(others, "OPTIONS")
+				sort.Strings(allowed)
+				rw.Header().Set("Allow", strings.Join(allowed, ","))
+				rw.WriteHeader(http.StatusOK)
+				return
+			}
 			ctx.Respond(rw, r, ctx.spec.RequiredProduces(), nil, errors.MethodNotAllowed(r.Method, others))
 			return
 		}
@@ -67,6 +86,15 @@ func newRouter(ctx *Context, next http.Handler) http.Handler {
 	})
 }
 
+// headResponseWriter discards the body of the response to a HEAD request
+type headResponseWriter struct {
+	http.ResponseWriter
+}
+
+func (h *headResponseWriter) Write(data []byte) (int, error) {
+	return len(data), nil
+}
+
 // RoutableAPI represents an interface for things that can serve
 // as a provider of implementations for the swagger router
 type RoutableAPI interface {
@@ -80,12 +108,23 @@ type RoutableAPI interface {
 	DefaultConsumes() string
 }
 
-// Router represents a swagger aware router
+// Router represents a swagger aware router.
+// A router is passed to NewContext or NewRoutableContext to replace the default denco based router,
+// when none is passed the default router is built from the spec.
 type Router interface {
 	Lookup(method, path string) (*MatchedRoute, bool)
 	OtherMethods(method, path string) []string
 }
 
+// RouteBuilder builds a router from the operations in a spec.
+// AddRoute is called once for every operation with its method and path template, relative to the base path.
+// Build is called after all the operations have been added.
+// The routes a builder returns for an operation are prepared with NewMatchedRoute.
+type RouteBuilder interface {
+	AddRoute(method, path string, operation *spec.Operation)
+	Build() Router
+}
+
 type defaultRouteBuilder struct {
 	spec    *spec.Document
 	api     RoutableAPI
@@ -108,7 +147,11 @@ func newDefaultRouteBuilder(spec *spec.Document, api RoutableAPI) *defaultRouteB
 
 // DefaultRouter creates a default implemenation of the router
 func DefaultRouter(spec *spec.Document, api RoutableAPI) Router {
-	builder := newDefaultRouteBuilder(spec, api)
+	return BuildRouter(spec, newDefaultRouteBuilder(spec, api))
+}
+
+// BuildRouter adds all the operations of the spec to the route builder and builds the router
+func BuildRouter(spec *spec.Document, builder RouteBuilder) Router {
 	if spec != nil {
 		for method, paths := range spec.Operations() {
 			for path, operation := range paths {
@@ -132,6 +175,7 @@ type routeEntry struct {
 	Formats        strfmt.Registry
 	Binder         *untypedRequestBinder
 	Authenticators map[string]httpkit.Authenticator
+	Security       [][]spec.SecurityRequirement
 }
 
 // MatchedRoute represents the route that was matched in this request
@@ -142,8 +186,31 @@ type MatchedRoute struct {
 	Producer httpkit.Producer
 }
 
+// NewMatchedRoute prepares the route for an operation, this is what a RouteBuilder keeps for an operation.
+// The router returns a copy of it for a request, with the values of the path parameters in Params.
+// It returns false when the api has no handler for the operation.
+func NewMatchedRoute(spec *spec.Document, api RoutableAPI, method, path string, operation *spec.Operation) (*MatchedRoute, bool) {
+	entry, ok := newRouteEntry(spec, api, method, path, operation)
+	if !ok {
+		return nil, false
+	}
+	return &MatchedRoute{routeEntry: *entry}, true
+}
+
 func (d *defaultRouter) Lookup(method, path string) (*MatchedRoute, bool) {
-	if router, ok := d.routers[strings.ToUpper(method)]; ok {
+	mn := strings.ToUpper(method)
+	if route, ok := d.lookup(mn, path); ok {
+		return route, true
+	}
+	// HEAD requests are served by the GET operation, the body of the response is discarded
+	if mn == "HEAD" {
+		return d.lookup("GET", path)
+	}
+	return nil, false
+}
+
+func (d *defaultRouter) lookup(method, path string) (*MatchedRoute, bool) {
+	if router, ok := d.routers[method]; ok {
 		if m, rp, ok := router.Lookup(path); ok && m != nil {
 			if entry, ok := m.(*routeEntry); ok {
 				var params RouteParams
@@ -157,48 +224,73 @@ func (d *defaultRouter) Lookup(method, path string) (*MatchedRoute, bool) {
 	return nil, false
 }
 
+// OtherMethods returns the sorted methods the path can be requested with, apart from the specified method.
+// HEAD is available for paths with a GET operation and OPTIONS is available for every path.
 func (d *defaultRouter) OtherMethods(method, path string) []string {
-	mn := strings.ToUpper(method)
-	var methods []string
+	available := make(map[string]bool)
 	for k, v := range d.routers {
-		if k != mn {
-			if _, _, ok := v.Lookup(path); ok {
-				methods = append(methods, k)
-				continue
-			}
+		if _, _, ok := v.Lookup(path); ok {
+			available[k] = true
 		}
 	}
+	if len(available) == 0 {
+		return nil
+	}
+	if available["GET"] {
+		available["HEAD"] = true
+	}
+	available["OPTIONS"] = true
+	delete(available, strings.ToUpper(method))
+
+	methods := make([]string, 0, len(available))
+	for k := range available {
+		methods = append(methods, k)
+	}
+	sort.Strings(methods)
 	return methods
 }
 
 var pathConverter = regexp.MustCompile(`{(\w+)}`)
 
 func (d *defaultRouteBuilder) AddRoute(method, path string, operation *spec.Operation) {
-	mn := strings.ToUpper(method)
-
-	if handler, ok := d.api.HandlerFor(operation.ID); ok {
-		consumes := d.spec.ConsumesFor(operation)
-		produces := d.spec.ProducesFor(operation)
-		parameters := d.spec.ParamsFor(method, path)
-		definitions := d.spec.SecurityDefinitionsFor(operation)
-
-		record := denco.NewRecord(pathConverter.ReplaceAllString(path, ":$1"), &routeEntry{
-			Operation:      operation,
-			Handler:        handler,
-			Consumes:       consumes,
-			Produces:       produces,
-			Consumers:      d.api.ConsumersFor(consumes),
-			Producers:      d.api.ProducersFor(produces),
-			Parameters:     parameters,
-			Formats:        d.api.Formats(),
-			Binder:         newUntypedRequestBinder(parameters, d.spec.Spec(), d.api.Formats()),
-			Authenticators: d.api.AuthenticatorsFor(definitions),
-		})
+	if entry, ok := newRouteEntry(d.spec, d.api, method, path, operation); ok {
+		mn := strings.ToUpper(method)
+		record := denco.NewRecord(pathConverter.ReplaceAllString(path, ":$1"), entry)
 		d.records[mn] = append(d.records[mn], record)
 	}
 }
 
-func (d *defaultRouteBuilder) Build() *defaultRouter {
+// newRouteEntry collects everything needed to serve an operation,
+// it returns false when the api has no handler for the operation
+func newRouteEntry(spec *spec.Document, api RoutableAPI, method, path string, operation *spec.Operation) (*routeEntry, bool) {
+	handler, ok := api.HandlerFor(operation.ID)
+	if !ok {
+		return nil, false
+	}
+
+	consumes := spec.ConsumesFor(operation)
+	produces := spec.ProducesFor(operation)
+	parameters := spec.ParamsFor(method, path)
+	definitions := spec.SecurityDefinitionsFor(operation)
+
+	return &routeEntry{
+		PathPattern:    path,
+		BasePath:       spec.BasePath(),
+		Operation:      operation,
+		Handler:        handler,
+		Consumes:       consumes,
+		Produces:       produces,
+		Consumers:      api.ConsumersFor(consumes),
+		Producers:      api.ProducersFor(produces),
+		Parameters:     parameters,
+		Formats:        api.Formats(),
+		Binder:         newUntypedRequestBinder(parameters, spec.Spec(), api.Formats()),
+		Authenticators: api.AuthenticatorsFor(definitions),
+		Security:       spec.SecurityRequirementsFor(operation),
+	}, true
+}
+
+func (d *defaultRouteBuilder) Build() Router {
 	routers := make(map[string]*denco.Router)
 	for method, records := range d.records {
 		router := denco.New()
@@ -0,0 +1,124 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/httpkit/middleware"
+	"github.com/go-swagger/go-swagger/httpkit/middleware/untyped"
+	"github.com/go-swagger/go-swagger/internal/testing/petstore"
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+// handlerAPI serves the operations with plain http handlers
+type handlerAPI struct {
+	*untyped.API
+	handlers map[string]http.Handler
+}
+
+func (h *handlerAPI) HandlerFor(operationID string) (http.Handler, bool) {
+	handler, ok := h.handlers[operationID]
+	return handler, ok
+}
+
+func (h *handlerAPI) ServeErrorFor(operationID string) func(http.ResponseWriter, *http.Request, error) {
+	return h.ServeError
+}
+
+func (h *handlerAPI) DefaultProduces() string {
+	return h.API.DefaultProduces
+}
+
+func (h *handlerAPI) DefaultConsumes() string {
+	return h.API.DefaultConsumes
+}
+
+type segmentRoute struct {
+	segments []string
+	route    *middleware.MatchedRoute
+}
+
+// segmentRouteBuilder matches the paths segment by segment, it's a route builder outside of the middleware package
+type segmentRouteBuilder struct {
+	spec   *spec.Document
+	api    middleware.RoutableAPI
+	routes map[string][]segmentRoute
+}
+
+func (s *segmentRouteBuilder) AddRoute(method, path string, operation *spec.Operation) {
+	if route, ok := middleware.NewMatchedRoute(s.spec, s.api, method, path, operation); ok {
+		s.routes[method] = append(s.routes[method], segmentRoute{segments: strings.Split(path, "/"), route: route})
+	}
+}
+
+func (s *segmentRouteBuilder) Build() middleware.Router {
+	return &segmentRouter{routes: s.routes}
+}
+
+type segmentRouter struct {
+	routes map[string][]segmentRoute
+}
+
+func (s *segmentRouter) Lookup(method, path string) (*middleware.MatchedRoute, bool) {
+	segments := strings.Split(path, "/")
+	for _, candidate := range s.routes[strings.ToUpper(method)] {
+		if len(candidate.segments) != len(segments) {
+			continue
+		}
+		var params middleware.RouteParams
+		matched := true
+		for i, segment := range candidate.segments {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				params = append(params, middleware.RouteParam{Name: segment[1 : len(segment)-1], Value: segments[i]})
+				continue
+			}
+			if segment != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			route := *candidate.route
+			route.Params = params
+			return &route, true
+		}
+	}
+	return nil, false
+}
+
+func (s *segmentRouter) OtherMethods(method, path string) []string {
+	return nil
+}
+
+func TestExternalRouteBuilder(t *testing.T) {
+	doc, untypedAPI := petstore.NewAPI(t)
+
+	var ctx *middleware.Context
+	api := &handlerAPI{API: untypedAPI, handlers: map[string]http.Handler{
+		"getPetById": http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			route, _ := ctx.RouteInfo(r)
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(route.Operation.ID + " " + route.Params.Get("id")))
+		}),
+	}}
+	router := middleware.BuildRouter(doc, &segmentRouteBuilder{spec: doc, api: api, routes: make(map[string][]segmentRoute)})
+	ctx = middleware.NewRoutableContext(doc, api, router)
+
+	// only the operations with a handler get a route
+	_, ok := router.Lookup("GET", "/pets")
+	assert.False(t, ok)
+	route, ok := router.Lookup("GET", "/pets/1")
+	if assert.True(t, ok) {
+		assert.Equal(t, "/pets/{id}", route.PathPattern)
+		assert.Equal(t, "1", route.Params.Get("id"))
+	}
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/pets/1", nil)
+	ctx.APIHandler().ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "getPetById 1", recorder.Body.String())
+}
@@ -36,7 +36,7 @@ func TestRouterMiddleware(t *testing.T) {
 
 	methods := strings.Split(recorder.Header().Get("Allow"), ",")
 	sort.Sort(sort.StringSlice(methods))
-	assert.Equal(t, "GET,POST", strings.Join(methods, ","))
+	assert.Equal(t, "GET,HEAD,OPTIONS,POST", strings.Join(methods, ","))
 
 	recorder = httptest.NewRecorder()
 	request, _ = http.NewRequest("GET", "/nopets", nil)
@@ -62,7 +62,7 @@ func TestRouterMiddleware(t *testing.T) {
 
 	methods = strings.Split(recorder.Header().Get("Allow"), ",")
 	sort.Sort(sort.StringSlice(methods))
-	assert.Equal(t, "GET,POST", strings.Join(methods, ","))
+	assert.Equal(t, "GET,HEAD,OPTIONS,POST", strings.Join(methods, ","))
 
 	recorder = httptest.NewRecorder()
 	request, _ = http.NewRequest("GET", "/nopets", nil)
@@ -72,6 +72,52 @@ func TestRouterMiddleware(t *testing.T) {
 
 }
 
+func TestRouterOptionsAndHead(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	handler := NewContext(spec, api, nil).APIHandler()
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("OPTIONS", "/api/pets/1", nil)
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "DELETE,GET,HEAD,OPTIONS", recorder.Header().Get("Allow"))
+	assert.Empty(t, recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("OPTIONS", "/api/nopets", nil)
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("HEAD", "/api/pets", nil)
+	request.SetBasicAuth("admin", "admin")
+	request.Header.Set("Accept", "application/json")
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	assert.Empty(t, recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("PUT", "/api/pets", nil)
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	assert.Equal(t, "GET,HEAD,OPTIONS,POST", recorder.Header().Get("Allow"))
+}
+
+func TestRouterAllowHeaderWithCustomServeError(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	api.ServeError = func(rw http.ResponseWriter, r *http.Request, err error) {
+		rw.WriteHeader(http.StatusTeapot)
+	}
+	handler := NewContext(spec, api, nil).APIHandler()
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/api/pets", nil)
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusTeapot, recorder.Code)
+	assert.Equal(t, "GET,HEAD,OPTIONS,POST", recorder.Header().Get("Allow"))
+}
+
 func TestRouterBuilder(t *testing.T) {
 	spec, api := petstore.NewAPI(t)
 
@@ -110,9 +156,15 @@ func TestRouterStruct(t *testing.T) {
 	router := DefaultRouter(spec, newRoutableUntypedAPI(spec, api, new(Context)))
 
 	methods := router.OtherMethods("post", "/pets/{id}")
-	assert.Len(t, methods, 2)
+	assert.Equal(t, []string{"DELETE", "GET", "HEAD", "OPTIONS"}, methods)
+
+	entry, ok := router.Lookup("head", "/pets/{id}")
+	assert.True(t, ok)
+	if assert.NotNil(t, entry) {
+		assert.Equal(t, "getPetById", entry.Operation.ID)
+	}
 
-	entry, ok := router.Lookup("delete", "/pets/{id}")
+	entry, ok = router.Lookup("delete", "/pets/{id}")
 	assert.True(t, ok)
 	assert.NotNil(t, entry)
 	assert.Len(t, entry.Params, 1)
@@ -125,6 +177,47 @@ func TestRouterStruct(t *testing.T) {
 	assert.False(t, ok)
 }
 
+type stubRouter struct {
+	lookups int
+}
+
+func (s *stubRouter) Lookup(method, path string) (*MatchedRoute, bool) {
+	s.lookups++
+	return nil, false
+}
+
+func (s *stubRouter) OtherMethods(method, path string) []string {
+	return nil
+}
+
+func TestRouterCustom(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	router := new(stubRouter)
+	context := NewContext(spec, api, router)
+	mw := newRouter(context, http.HandlerFunc(terminator))
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/pets", nil)
+
+	mw.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, 1, router.lookups)
+}
+
+func TestBuildRouter(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	builder := newDefaultRouteBuilder(spec, newRoutableUntypedAPI(spec, api, new(Context)))
+	router := BuildRouter(spec, builder)
+
+	assert.Len(t, builder.records["GET"], 2)
+	entry, ok := router.Lookup("GET", "/pets/1")
+	if assert.True(t, ok) {
+		assert.Equal(t, "getPetById", entry.Operation.ID)
+		assert.Equal(t, "/pets/{id}", entry.PathPattern)
+		assert.Equal(t, "/api", entry.BasePath)
+	}
+}
+
 func petAPIRouterBuilder(spec *spec.Document, api *untyped.API) *defaultRouteBuilder {
 	builder := newDefaultRouteBuilder(spec, newRoutableUntypedAPI(spec, api, new(Context)))
 	builder.AddRoute("GET", "/pets", spec.AllPaths()["/pets"].Get)
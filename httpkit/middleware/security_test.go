@@ -5,7 +5,11 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/go-swagger/go-swagger/errors"
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/httpkit/security"
 	"github.com/go-swagger/go-swagger/internal/testing/petstore"
+	"github.com/go-swagger/go-swagger/spec"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,3 +46,144 @@ func TestSecurityMiddleware(t *testing.T) {
 	assert.Equal(t, 200, recorder.Code)
 
 }
+
+func TestAuthorizeScopes(t *testing.T) {
+	doc, api := petstore.NewAPI(t)
+	ctx := NewContext(doc, api, nil)
+
+	auth := security.BearerAuth(func(token string) (interface{}, []string, error) {
+		if token != "token123" {
+			return nil, nil, errors.Unauthenticated("petstore_auth")
+		}
+		return "admin", []string{"read:pets"}, nil
+	})
+
+	route := &MatchedRoute{routeEntry: routeEntry{
+		Authenticators: map[string]httpkit.Authenticator{"petstore_auth": auth},
+		Security: [][]spec.SecurityRequirement{
+			{{Name: "petstore_auth", Scopes: []string{"read:pets", "write:pets"}}},
+		},
+	}}
+
+	request, _ := http.NewRequest("GET", "/pets", nil)
+	request.Header.Set("Authorization", "Bearer wrong")
+	_, err := ctx.Authorize(request, route)
+	if assert.Error(t, err) {
+		assert.EqualValues(t, 401, err.(errors.Error).Code())
+	}
+
+	request, _ = http.NewRequest("GET", "/pets", nil)
+	request.Header.Set("Authorization", "Bearer token123")
+	_, err = ctx.Authorize(request, route)
+	if assert.Error(t, err) {
+		assert.EqualValues(t, 403, err.(errors.Error).Code())
+	}
+
+	route.Security[0][0].Scopes = []string{"read:pets"}
+	request, _ = http.NewRequest("GET", "/pets", nil)
+	request.Header.Set("Authorization", "Bearer token123")
+	p, err := ctx.Authorize(request, route)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", p)
+}
+
+func TestAuthorizeRequirementSets(t *testing.T) {
+	doc, api := petstore.NewAPI(t)
+	ctx := NewContext(doc, api, nil)
+
+	basic := security.BasicAuth(func(user, pass string) (interface{}, error) {
+		if user == "admin" && pass == "admin" {
+			return "admin", nil
+		}
+		return nil, errors.Unauthenticated("basic")
+	})
+	apiKey := security.APIKeyAuth("X-API-KEY", "header", func(token string) (interface{}, error) {
+		if token == "token123" {
+			return "service", nil
+		}
+		return nil, errors.Unauthenticated("api_key")
+	})
+	bearer := security.BearerAuth(func(token string) (interface{}, []string, error) {
+		if token == "token123" {
+			return "bearer", []string{"read"}, nil
+		}
+		return nil, nil, errors.Unauthenticated("oauth")
+	})
+
+	// (basic AND api_key) OR oauth
+	route := &MatchedRoute{routeEntry: routeEntry{
+		Authenticators: map[string]httpkit.Authenticator{"basic": basic, "api_key": apiKey, "oauth": bearer},
+		Security: [][]spec.SecurityRequirement{
+			{{Name: "basic"}, {Name: "api_key"}},
+			{{Name: "oauth", Scopes: []string{"read"}}},
+		},
+	}}
+
+	request, _ := http.NewRequest("GET", "/pets", nil)
+	request.SetBasicAuth("admin", "admin")
+	_, err := ctx.Authorize(request, route)
+	assert.Error(t, err)
+
+	request, _ = http.NewRequest("GET", "/pets", nil)
+	request.Header.Set("X-API-KEY", "token123")
+	_, err = ctx.Authorize(request, route)
+	assert.Error(t, err)
+
+	request, _ = http.NewRequest("GET", "/pets", nil)
+	request.SetBasicAuth("admin", "admin")
+	request.Header.Set("X-API-KEY", "token123")
+	p, err := ctx.Authorize(request, route)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", p)
+
+	request, _ = http.NewRequest("GET", "/pets?access_token=token123", nil)
+	p, err = ctx.Authorize(request, route)
+	assert.NoError(t, err)
+	assert.Equal(t, "bearer", p)
+
+	// an empty requirement set allows anonymous access
+	route.Security = append(route.Security, []spec.SecurityRequirement{})
+	request, _ = http.NewRequest("GET", "/pets", nil)
+	p, err = ctx.Authorize(request, route)
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+	assert.Nil(t, p)
+}
+
+func TestAuthorizeAnonymousFallback(t *testing.T) {
+	doc, api := petstore.NewAPI(t)
+	ctx := NewContext(doc, api, nil)
+
+	apiKey := security.APIKeyAuth("X-API-KEY", "header", func(token string) (interface{}, error) {
+		if token == "token123" {
+			return "service", nil
+		}
+		return nil, errors.Unauthenticated("api_key")
+	})
+
+	// anonymous OR api_key
+	route := &MatchedRoute{routeEntry: routeEntry{
+		Authenticators: map[string]httpkit.Authenticator{"api_key": apiKey},
+		Security: [][]spec.SecurityRequirement{
+			{},
+			{{Name: "api_key"}},
+		},
+	}}
+
+	request, _ := http.NewRequest("GET", "/pets", nil)
+	request.Header.Set("X-API-KEY", "token123")
+	p, err := ctx.Authorize(request, route)
+	assert.NoError(t, err)
+	assert.Equal(t, "service", p)
+
+	request, _ = http.NewRequest("GET", "/pets", nil)
+	p, err = ctx.Authorize(request, route)
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+
+	request, _ = http.NewRequest("GET", "/pets", nil)
+	request.Header.Set("X-API-KEY", "wrong")
+	p, err = ctx.Authorize(request, route)
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+}
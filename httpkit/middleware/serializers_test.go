@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/httpkit/middleware/untyped"
+	"github.com/go-swagger/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+const serializersSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "serializers", "version": "1.0.0"},
+  "paths": {
+    "/tasks": {
+      "get": {
+        "operationId": "exportTasks",
+        "produces": ["text/csv"],
+        "responses": {"200": {"description": "the tasks"}}
+      }
+    },
+    "/notes": {
+      "post": {
+        "operationId": "echoNote",
+        "consumes": ["text/plain"],
+        "produces": ["text/plain"],
+        "parameters": [{"name": "note", "in": "body", "required": true, "schema": {"type": "string"}}],
+        "responses": {"200": {"description": "the note"}}
+      }
+    },
+    "/download": {
+      "get": {
+        "operationId": "download",
+        "produces": ["application/octet-stream"],
+        "responses": {"200": {"description": "the file"}}
+      }
+    }
+  }
+}`
+
+func TestStockSerializers(t *testing.T) {
+	doc, err := spec.New(json.RawMessage(serializersSpec), "")
+	assert.NoError(t, err)
+
+	type task struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	}
+
+	api := untyped.NewAPI(doc)
+	api.RegisterConsumer(httpkit.TextMime, httpkit.TextConsumer())
+	api.RegisterProducer(httpkit.TextMime, httpkit.TextProducer())
+	api.RegisterProducer(httpkit.CSVMime, httpkit.CSVProducer())
+	api.RegisterProducer(httpkit.ByteStreamMime, httpkit.ByteStreamProducer())
+	api.RegisterOperation("exportTasks", httpkit.OperationHandlerFunc(func(params interface{}) (interface{}, error) {
+		return []task{{ID: 1, Name: "write tests"}}, nil
+	}))
+	api.RegisterOperation("echoNote", httpkit.OperationHandlerFunc(func(params interface{}) (interface{}, error) {
+		return params.(map[string]interface{})["note"], nil
+	}))
+	api.RegisterOperation("download", httpkit.OperationHandlerFunc(func(params interface{}) (interface{}, error) {
+		return bytes.NewReader([]byte{0x00, 0x01}), nil
+	}))
+	ctx := NewContext(doc, api, nil)
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/tasks", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.CSVMime)
+	ctx.APIHandler().ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, httpkit.CSVMime, recorder.Header().Get(httpkit.HeaderContentType))
+	assert.Equal(t, "id,name\n1,write tests\n", recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/notes", bytes.NewBufferString("remember the milk"))
+	request.Header.Set(httpkit.HeaderContentType, "text/plain; charset=utf-8")
+	request.Header.Set(httpkit.HeaderAccept, httpkit.TextMime)
+	ctx.APIHandler().ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "remember the milk", recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/download", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.ByteStreamMime)
+	ctx.APIHandler().ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, httpkit.ByteStreamMime, recorder.Header().Get(httpkit.HeaderContentType))
+	assert.Equal(t, []byte{0x00, 0x01}, recorder.Body.Bytes())
+}
@@ -3,16 +3,66 @@ package middleware
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 
+	"github.com/go-swagger/go-swagger/httpkit"
 	"github.com/go-swagger/go-swagger/spec"
+	"github.com/go-swagger/go-swagger/swag"
 )
 
+// SpecRewriter changes the spec before it's served, for example to set the host, schemes and base path
+// the API can be reached on. The rewriter gets a shallow copy of the spec for every request.
+type SpecRewriter func(r *http.Request, swspec *spec.Swagger)
+
+// SetSpecRewriter registers a rewriter for the spec served by the API handler
+func (c *Context) SetSpecRewriter(rewriter SpecRewriter) {
+	c.specRewriter = rewriter
+}
+
+// RewriteSpecFromRequest is a spec rewriter that sets the host and the scheme from the request.
+// The X-Forwarded-Host, X-Forwarded-Proto and X-Forwarded-Prefix headers set by proxies take precedence.
+func RewriteSpecFromRequest(r *http.Request, swspec *spec.Swagger) {
+	swspec.Host = r.Host
+	if host := r.Header.Get("X-Forwarded-Host"); host != "" {
+		swspec.Host = host
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = strings.ToLower(proto)
+	}
+	swspec.Schemes = []string{scheme}
+
+	if prefix := strings.TrimSuffix(r.Header.Get("X-Forwarded-Prefix"), "/"); prefix != "" {
+		swspec.BasePath = prefix + "/" + strings.TrimPrefix(swspec.BasePath, "/")
+	}
+}
+
 func specMiddleware(ctx *Context, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == "/swagger.json" {
-			rw.Header().Set("Content-Type", "application/json")
-			rw.WriteHeader(http.StatusOK)
-			rw.Write(ctx.spec.Raw())
+		if format, ok := specFormat(r, ctx.BasePath()); ok {
+			if ctx.cors != nil {
+				ctx.writeCORSHeaders(rw, r)
+				if isPreflight(r) {
+					ctx.serveSpecPreflight(rw, r)
+					return
+				}
+			}
+			data := ctx.spec.Raw()
+			if ctx.specRewriter != nil {
+				swspec := *ctx.spec.Spec()
+				ctx.specRewriter(r, &swspec)
+				b, err := json.Marshal(&swspec)
+				if err != nil {
+					ctx.Respond(rw, r, []string{httpkit.JSONMime}, nil, err)
+					return
+				}
+				data = b
+			}
+			serveSpec(rw, r, format, data)
 			return
 		}
 		if next == nil {
@@ -23,24 +73,62 @@ func specMiddleware(ctx *Context, next http.Handler) http.Handler {
 	})
 }
 
+// specFormat returns the media type to serve the spec with, when the request is for the spec.
+// The spec is served as /swagger.json and /swagger.yaml, /swagger negotiates the format with the accept header.
+// These paths are available both at the root and below the base path.
+func specFormat(r *http.Request, basePath string) (string, bool) {
+	pth := r.URL.Path
+	if basePath != "" && basePath != "/" {
+		pth = strings.TrimPrefix(pth, strings.TrimSuffix(basePath, "/"))
+	}
+
+	switch pth {
+	case "/swagger.json":
+		return httpkit.JSONMime, true
+	case "/swagger.yaml":
+		return httpkit.YAMLMime, true
+	case "/swagger":
+		return httpkit.NegotiateContentType(r, []string{httpkit.JSONMime, httpkit.YAMLMime}, httpkit.JSONMime), true
+	}
+	return "", false
+}
+
+// serveSpec writes the json document of the spec in the requested format
+func serveSpec(rw http.ResponseWriter, r *http.Request, format string, data []byte) {
+	if format == httpkit.YAMLMime {
+		b, err := swag.JSONToYAML(data)
+		if err != nil {
+			rw.Header().Set(httpkit.HeaderContentType, httpkit.JSONMime)
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		data = b
+	}
+
+	rw.Header().Set(httpkit.HeaderContentType, format)
+	rw.WriteHeader(http.StatusOK)
+	if r.Method != "HEAD" {
+		rw.Write(data)
+	}
+}
+
 // Spec creates a middleware to serve a swagger spec.
 // This allows for altering the spec before starting the http listener.
-// This can be useful
-//
+// The spec is served as json and yaml, like the spec served by the API handler.
 func Spec(basePath string, swsp *spec.Swagger, next http.Handler) http.Handler {
-	if basePath == "/" {
-		basePath = ""
-	}
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == basePath+"/swagger.json" {
-			rw.Header().Set("Content-Type", "application/json")
-			rw.WriteHeader(http.StatusOK)
-			dec := json.NewEncoder(rw)
-			dec.Encode(swsp)
+		if format, ok := specFormat(r, basePath); ok {
+			data, err := json.Marshal(swsp)
+			if err != nil {
+				rw.Header().Set(httpkit.HeaderContentType, httpkit.JSONMime)
+				rw.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			serveSpec(rw, r, format, data)
 			return
 		}
 		if next == nil {
-			rw.Header().Set("Content-Type", "application/json")
+			rw.Header().Set(httpkit.HeaderContentType, httpkit.JSONMime)
 			rw.WriteHeader(http.StatusNotFound)
 			return
 		}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -8,6 +9,7 @@ import (
 	"github.com/go-swagger/go-swagger/httpkit"
 	"github.com/go-swagger/go-swagger/internal/testing/petstore"
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
 )
 
 func TestServeSpecMiddleware(t *testing.T) {
@@ -40,3 +42,70 @@ func TestServeSpecMiddleware(t *testing.T) {
 	assert.Equal(t, 200, recorder.Code)
 
 }
+
+func TestServeSpecFormats(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	handler := specMiddleware(NewContext(spec, api, nil), nil)
+
+	for _, pth := range []string{"/swagger.json", "/api/swagger.json", "/swagger"} {
+		request, _ := http.NewRequest("GET", pth, nil)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		assert.Equal(t, 200, recorder.Code, pth)
+		assert.Equal(t, httpkit.JSONMime, recorder.Header().Get(httpkit.HeaderContentType), pth)
+		assert.Equal(t, []byte(spec.Raw()), recorder.Body.Bytes(), pth)
+	}
+
+	request, _ := http.NewRequest("GET", "/swagger", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.YAMLMime)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, httpkit.YAMLMime, recorder.Header().Get(httpkit.HeaderContentType))
+
+	request, _ = http.NewRequest("GET", "/api/swagger.yaml", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, httpkit.YAMLMime, recorder.Header().Get(httpkit.HeaderContentType))
+
+	var doc map[string]interface{}
+	if assert.NoError(t, yaml.Unmarshal(recorder.Body.Bytes(), &doc)) {
+		assert.Equal(t, "2.0", doc["swagger"])
+		assert.Equal(t, "/api", doc["basePath"])
+	}
+}
+
+func TestServeSpecRewriter(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	ctx := NewContext(spec, api, nil)
+	ctx.SetSpecRewriter(RewriteSpecFromRequest)
+	handler := specMiddleware(ctx, nil)
+
+	request, _ := http.NewRequest("GET", "http://10.0.0.1:8080/swagger.json", nil)
+	request.Header.Set("X-Forwarded-Host", "api.example.com")
+	request.Header.Set("X-Forwarded-Proto", "https")
+	request.Header.Set("X-Forwarded-Prefix", "/petstore/")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	var doc map[string]interface{}
+	if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &doc)) {
+		assert.Equal(t, "api.example.com", doc["host"])
+		assert.Equal(t, []interface{}{"https"}, doc["schemes"])
+		assert.Equal(t, "/petstore/api", doc["basePath"])
+	}
+
+	// the spec of the context isn't changed
+	assert.Equal(t, "/api", spec.BasePath())
+
+	request, _ = http.NewRequest("GET", "http://10.0.0.1:8080/swagger.json", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &doc)) {
+		assert.Equal(t, "10.0.0.1:8080", doc["host"])
+		assert.Equal(t, []interface{}{"http"}, doc["schemes"])
+		assert.Equal(t, "/api", doc["basePath"])
+	}
+}
@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"html"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-swagger/go-swagger/assets"
+)
+
+// SwaggerUIOpts configures the SwaggerUI middleware
+type SwaggerUIOpts struct {
+	// BasePath is the path the API is served at, it defaults to /
+	BasePath string
+	// Path is the path of the UI below the base path, it defaults to docs
+	Path string
+	// SpecURL is the url of the spec the UI documents, it defaults to /swagger.json
+	SpecURL string
+	// Title is the title of the page, it defaults to API documentation
+	Title string
+}
+
+func (o *SwaggerUIOpts) ensureDefaults() {
+	if o.BasePath == "" {
+		o.BasePath = "/"
+	}
+	if o.Path == "" {
+		o.Path = "docs"
+	}
+	if o.SpecURL == "" {
+		o.SpecURL = "/swagger.json"
+	}
+	if o.Title == "" {
+		o.Title = "API documentation"
+	}
+}
+
+const swaggerUIAssets = "swagger-ui"
+
+type uiFile struct {
+	data    []byte
+	modTime time.Time
+}
+
+// SwaggerUI creates a middleware to serve swagger-ui for the spec served at the spec url.
+// The swagger-ui dist is embedded in the assets package, the UI doesn't load any external resources,
+// so it also works on networks without access to the internet.
+func SwaggerUI(opts SwaggerUIOpts, next http.Handler) http.Handler {
+	opts.ensureDefaults()
+	pth := path.Join("/", opts.BasePath, opts.Path)
+	files := swaggerUIFiles(opts)
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != pth && !strings.HasPrefix(r.URL.Path, pth+"/") {
+			if next == nil {
+				http.NotFound(rw, r)
+				return
+			}
+			next.ServeHTTP(rw, r)
+			return
+		}
+
+		// the dist refers to its files relative to the index page
+		if r.URL.Path == pth {
+			http.Redirect(rw, r, pth+"/", http.StatusFound)
+			return
+		}
+
+		name := strings.TrimPrefix(r.URL.Path, pth+"/")
+		if name == "" {
+			name = "index.html"
+		}
+		file, ok := files[name]
+		if !ok {
+			http.NotFound(rw, r)
+			return
+		}
+		http.ServeContent(rw, r, name, file.modTime, bytes.NewReader(file.data))
+	})
+}
+
+// swaggerUIFiles loads the embedded swagger-ui dist, with the index page titled
+// and an initializer that points the UI at the spec url
+func swaggerUIFiles(opts SwaggerUIOpts) map[string]uiFile {
+	names, err := assets.AssetDir(swaggerUIAssets)
+	if err != nil {
+		// the dist is embedded at build time, so this is a programming error
+		panic(err)
+	}
+
+	files := make(map[string]uiFile, len(names)+1)
+	for _, name := range names {
+		b, err := assets.Asset(path.Join(swaggerUIAssets, name))
+		if err != nil {
+			panic(err)
+		}
+		info, err := assets.AssetInfo(path.Join(swaggerUIAssets, name))
+		if err != nil {
+			panic(err)
+		}
+		if name == "index.html" {
+			b = bytes.Replace(b, []byte("<title>Swagger UI</title>"), []byte("<title>"+html.EscapeString(opts.Title)+"</title>"), 1)
+		}
+		files[name] = uiFile{data: b, modTime: info.ModTime()}
+	}
+
+	// json escapes the characters that could end the script, so the url is safe to put in there as a string
+	specURL, err := json.Marshal(opts.SpecURL)
+	if err != nil {
+		panic(err)
+	}
+	files["swagger-initializer.js"] = uiFile{
+		data:    []byte(strings.Replace(swaggerUIInitializer, "{{specURL}}", string(specURL), 1)),
+		modTime: files["index.html"].modTime,
+	}
+	return files
+}
+
+var swaggerUIInitializer = `window.onload = function() {
+  window.ui = SwaggerUIBundle({
+    url: {{specURL}},
+    dom_id: "#swagger-ui",
+    deepLinking: true,
+    presets: [
+      SwaggerUIBundle.presets.apis,
+      SwaggerUIStandalonePreset
+    ],
+    plugins: [
+      SwaggerUIBundle.plugins.DownloadUrl
+    ],
+    layout: "StandaloneLayout"
+  });
+};
+`
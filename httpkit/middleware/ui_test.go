@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwaggerUI(t *testing.T) {
+	handler := SwaggerUI(SwaggerUIOpts{BasePath: "/api", SpecURL: "/api/swagger.json", Title: "Pets <API>"}, http.HandlerFunc(terminator))
+
+	request, _ := http.NewRequest("GET", "/api/docs", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusFound, recorder.Code)
+	assert.Equal(t, "/api/docs/", recorder.Header().Get("Location"))
+
+	request, _ = http.NewRequest("GET", "/api/docs/", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/html; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), "<title>Pets &lt;API&gt;</title>")
+	assert.Contains(t, recorder.Body.String(), `src="./swagger-ui-bundle.js"`)
+
+	// the bundle is embedded, so nothing is loaded from elsewhere
+	request, _ = http.NewRequest("GET", "/api/docs/swagger-ui-bundle.js", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Header().Get("Content-Type"), "javascript")
+	assert.Contains(t, recorder.Body.String(), "SwaggerUIBundle")
+
+	request, _ = http.NewRequest("GET", "/api/docs/swagger-initializer.js", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `url: "/api/swagger.json",`)
+	assert.NotContains(t, recorder.Body.String(), "petstore")
+
+	request, _ = http.NewRequest("GET", "/api/docs/missing.js", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	// other requests are passed on
+	request, _ = http.NewRequest("GET", "/api/docsearch", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Empty(t, recorder.Body.String())
+}
+
+func TestSwaggerUISpecURL(t *testing.T) {
+	// the spec url can't break out of the script
+	handler := SwaggerUI(SwaggerUIOpts{Path: "swagger-ui", SpecURL: `/swagger.json?a="b"</script>`}, nil)
+
+	request, _ := http.NewRequest("GET", "/swagger-ui/swagger-initializer.js", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `url: "/swagger.json?a=\"b\"\u003c/script\u003e",`)
+
+	request, _ = http.NewRequest("GET", "/other", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/go-swagger/go-swagger/httpkit"
 	"github.com/go-swagger/go-swagger/spec"
 	"github.com/go-swagger/go-swagger/strfmt"
+	"github.com/go-swagger/go-swagger/swag"
 )
 
 // NewAPI creates the default untyped API
@@ -19,13 +20,16 @@ func NewAPI(spec *spec.Document) *API {
 		DefaultProduces: "application/json",
 		DefaultConsumes: "application/json",
 		consumers: map[string]httpkit.Consumer{
-			"application/json": httpkit.JSONConsumer(),
+			httpkit.JSONMime: httpkit.JSONConsumer(),
+			httpkit.XMLMime:  httpkit.XMLConsumer(),
 		},
 		producers: map[string]httpkit.Producer{
-			"application/json": httpkit.JSONProducer(),
+			httpkit.JSONMime: httpkit.JSONProducer(),
+			httpkit.XMLMime:  httpkit.XMLProducer(),
 		},
 		authenticators: make(map[string]httpkit.Authenticator),
 		operations:     make(map[string]httpkit.OperationHandler),
+		interceptors:   make(map[string][]Interceptor),
 		ServeError:     errors.ServeError,
 		Models:         make(map[string]func() interface{}),
 		formats:        strfmt.NewFormats(),
@@ -41,6 +45,8 @@ type API struct {
 	producers       map[string]httpkit.Producer
 	authenticators  map[string]httpkit.Authenticator
 	operations      map[string]httpkit.OperationHandler
+	global          []Interceptor
+	interceptors    map[string][]Interceptor
 	ServeError      func(http.ResponseWriter, *http.Request, error)
 	Models          map[string]func() interface{}
 	formats         strfmt.Registry
@@ -82,12 +88,35 @@ func (d *API) OperationHandlerFor(operationID string) (httpkit.OperationHandler,
 	return h, ok
 }
 
+// RegisterInterceptor registers an interceptor for all the operations,
+// interceptors are called in the order they were registered
+func (d *API) RegisterInterceptor(interceptor Interceptor) {
+	d.global = append(d.global, interceptor)
+}
+
+// RegisterOperationInterceptor registers an interceptor for an operation name,
+// these are called after the interceptors registered for all the operations
+func (d *API) RegisterOperationInterceptor(operationID string, interceptor Interceptor) {
+	d.interceptors[operationID] = append(d.interceptors[operationID], interceptor)
+}
+
+// InterceptorsFor returns the interceptors for the specified operation id, in the order they need to be called
+func (d *API) InterceptorsFor(operationID string) []Interceptor {
+	var result []Interceptor
+	result = append(result, d.global...)
+	return append(result, d.interceptors[operationID]...)
+}
+
 // ConsumersFor gets the consumers for the specified media types
 func (d *API) ConsumersFor(mediaTypes []string) map[string]httpkit.Consumer {
 	result := make(map[string]httpkit.Consumer)
 	for _, mt := range mediaTypes {
 		if consumer, ok := d.consumers[mt]; ok {
 			result[mt] = consumer
+			continue
+		}
+		if registered, ok := httpkit.LookupMediaType(d.consumerMediaTypes(), mt); ok {
+			result[mt] = d.consumers[registered]
 		}
 	}
 	return result
@@ -99,8 +128,32 @@ func (d *API) ProducersFor(mediaTypes []string) map[string]httpkit.Producer {
 	for _, mt := range mediaTypes {
 		if producer, ok := d.producers[mt]; ok {
 			result[mt] = producer
+			continue
 		}
+		if registered, ok := httpkit.LookupMediaType(d.producerMediaTypes(), mt); ok {
+			result[mt] = d.producers[registered]
+		}
+	}
+	return result
+}
+
+// consumerMediaTypes returns the media types of the registered consumers in a stable order
+func (d *API) consumerMediaTypes() []string {
+	var result []string
+	for k := range d.consumers {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// producerMediaTypes returns the media types of the registered producers in a stable order
+func (d *API) producerMediaTypes() []string {
+	var result []string
+	for k := range d.producers {
+		result = append(result, k)
 	}
+	sort.Strings(result)
 	return result
 }
 
@@ -122,15 +175,10 @@ func (d *API) Validate() error {
 
 // validateWith validates the registrations in this API against the provided spec analyzer
 func (d *API) validate() error {
-	var consumes []string
-	for k := range d.consumers {
-		consumes = append(consumes, k)
-	}
-
-	var produces []string
-	for k := range d.producers {
-		produces = append(produces, k)
-	}
+	consumes := d.consumerMediaTypes()
+	requiredConsumes := coveredMediaTypes(consumes, d.spec.RequiredConsumes())
+	produces := d.producerMediaTypes()
+	requiredProduces := coveredMediaTypes(produces, d.spec.RequiredProduces())
 
 	var authenticators []string
 	for k := range d.authenticators {
@@ -147,10 +195,10 @@ func (d *API) validate() error {
 		definedAuths = append(definedAuths, k)
 	}
 
-	if err := d.verify("consumes", consumes, d.spec.RequiredConsumes()); err != nil {
+	if err := d.verify("consumes", withoutUnusedDefaults(consumes, requiredConsumes), requiredConsumes); err != nil {
 		return err
 	}
-	if err := d.verify("produces", produces, d.spec.RequiredProduces()); err != nil {
+	if err := d.verify("produces", withoutUnusedDefaults(produces, requiredProduces), requiredProduces); err != nil {
 		return err
 	}
 	if err := d.verify("operation", operations, d.spec.OperationIDs()); err != nil {
@@ -168,6 +216,41 @@ func (d *API) validate() error {
 	return nil
 }
 
+// coveredMediaTypes replaces the required media types that are served by a registration for a matching media type,
+// like application/vnd.acme+json by application/json, with the media type of that registration
+func coveredMediaTypes(registrations []string, required []string) []string {
+	seen := make(map[string]bool, len(required))
+	var result []string
+	for _, mt := range required {
+		if registered, ok := httpkit.LookupMediaType(registrations, mt); ok {
+			mt = registered
+		}
+		if !seen[mt] {
+			seen[mt] = true
+			result = append(result, mt)
+		}
+	}
+	return result
+}
+
+// the media types NewAPI registers a consumer and a producer for, a spec doesn't need to use them
+var defaultMediaTypes = map[string]bool{
+	httpkit.JSONMime: true,
+	httpkit.XMLMime:  true,
+}
+
+// withoutUnusedDefaults removes the default registrations the spec doesn't use
+func withoutUnusedDefaults(registrations []string, required []string) []string {
+	var result []string
+	for _, mt := range registrations {
+		if defaultMediaTypes[mt] && !swag.ContainsStringsCI(required, mt) {
+			continue
+		}
+		result = append(result, mt)
+	}
+	return result
+}
+
 func (d *API) verify(name string, registrations []string, expectations []string) error {
 
 	sort.Sort(sort.StringSlice(registrations))
@@ -60,6 +60,10 @@ func TestUntypedAPIRegistrations(t *testing.T) {
 	assert.True(t, ok)
 	_, ok = api.producers["application/json"]
 	assert.True(t, ok)
+	_, ok = api.consumers["application/xml"]
+	assert.True(t, ok)
+	_, ok = api.producers["application/xml"]
+	assert.True(t, ok)
 	_, ok = api.operations["someId"]
 	assert.True(t, ok)
 
@@ -258,3 +262,20 @@ func TestUntypedAppValidation(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "hello", p)
 }
+
+func TestUntypedAPIInterceptors(t *testing.T) {
+	api := NewAPI(new(swaggerspec.Document))
+	assert.Empty(t, api.InterceptorsFor("someId"))
+
+	api.RegisterOperationInterceptor("someId", Interceptor{AroundHandler: func(_ *swaggerspec.Operation, next httpkit.OperationHandler) httpkit.OperationHandler {
+		return next
+	}})
+	api.RegisterInterceptor(Interceptor{})
+
+	interceptors := api.InterceptorsFor("someId")
+	if assert.Len(t, interceptors, 2) {
+		assert.Nil(t, interceptors[0].AroundHandler)
+		assert.NotNil(t, interceptors[1].AroundHandler)
+	}
+	assert.Len(t, api.InterceptorsFor("otherId"), 1)
+}
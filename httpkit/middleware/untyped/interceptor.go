@@ -0,0 +1,31 @@
+package untyped
+
+import (
+	"net/http"
+
+	"github.com/go-swagger/go-swagger/httpkit"
+	"github.com/go-swagger/go-swagger/spec"
+)
+
+// Interceptor hooks into the steps of serving an operation of the untyped API.
+// Every hook is optional, they receive the operation that was matched for the request.
+//
+// The steps of serving an operation are:
+//
+//	BeforeBind -> authorize -> AfterAuth -> bind and validate -> AroundHandler -> respond -> AfterRespond
+type Interceptor struct {
+	// BeforeBind is called before the request gets authorized and bound,
+	// returning an error stops the request and responds with that error
+	BeforeBind func(r *http.Request, operation *spec.Operation) error
+
+	// AfterAuth is called once the request was authorized, the principal is nil for operations without security,
+	// returning an error stops the request and responds with that error
+	AfterAuth func(r *http.Request, operation *spec.Operation, principal interface{}) error
+
+	// AroundHandler wraps the operation handler, the wrapper receives the bound parameters
+	AroundHandler func(operation *spec.Operation, next httpkit.OperationHandler) httpkit.OperationHandler
+
+	// AfterRespond is called after the response was written with the bound parameters and
+	// the result of the request, this is either the result of the handler or an error
+	AfterRespond func(r *http.Request, operation *spec.Operation, params interface{}, result interface{})
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/go-swagger/go-swagger/errors"
 	"github.com/go-swagger/go-swagger/httpkit"
-	"github.com/go-swagger/go-swagger/swag"
 )
 
 // NewValidation starts a new validation middleware
@@ -42,13 +41,13 @@ func (ub untypedBinder) BindRequest(r *http.Request, route *MatchedRoute, consum
 	return nil
 }
 
-// ContentType validates the content type of a request
+// ContentType validates the content type of a request,
+// the allowed media types can be wildcards and application/json also allows application/vnd.acme+json
 func validateContentType(allowed []string, actual string) *errors.Validation {
-	mt, _, err := mime.ParseMediaType(actual)
-	if err != nil {
+	if _, _, err := mime.ParseMediaType(actual); err != nil {
 		return errors.InvalidContentType(actual, allowed)
 	}
-	if swag.ContainsStringsCI(allowed, mt) {
+	if _, ok := httpkit.LookupMediaType(allowed, actual); ok {
 		return nil
 	}
 	return errors.InvalidContentType(actual, allowed)
@@ -92,7 +91,7 @@ func (v *validation) contentType() {
 			if err := validateContentType(v.route.Consumes, ct); err != nil {
 				v.result = append(v.result, err)
 			}
-			v.route.Consumer = v.route.Consumers[ct]
+			v.route.Consumer = consumerFor(v.route.Consumers, ct)
 		}
 	}
 }
@@ -8,22 +8,50 @@ import (
 	"github.com/go-swagger/go-swagger/httpkit"
 )
 
+const bearerPrefix = "Bearer "
+
 // httpAuthenticator is a function that authenticates a HTTP request
 func httpAuthenticator(handler func(*http.Request) (bool, interface{}, error)) httpkit.Authenticator {
 	return httpkit.AuthenticatorFunc(func(params interface{}) (bool, interface{}, error) {
 		if request, ok := params.(*http.Request); ok {
 			return handler(request)
 		}
+		if scoped, ok := params.(*ScopedAuthRequest); ok {
+			return handler(scoped.Request)
+		}
 		return false, nil, nil
 	})
 }
 
+// scopedAuthenticator is a function that authenticates a HTTP request with the scopes required by the operation
+func scopedAuthenticator(handler func(*ScopedAuthRequest) (bool, interface{}, error)) httpkit.Authenticator {
+	return httpkit.AuthenticatorFunc(func(params interface{}) (bool, interface{}, error) {
+		if request, ok := params.(*ScopedAuthRequest); ok {
+			return handler(request)
+		}
+		if request, ok := params.(*http.Request); ok {
+			return handler(&ScopedAuthRequest{Request: request})
+		}
+		return false, nil, nil
+	})
+}
+
+// ScopedAuthRequest contains both a http request and the required scopes for a particular operation
+type ScopedAuthRequest struct {
+	Request        *http.Request
+	RequiredScopes []string
+}
+
 // UserPassAuthentication authentication function
 type UserPassAuthentication func(string, string) (interface{}, error)
 
 // TokenAuthentication authentication function
 type TokenAuthentication func(string) (interface{}, error)
 
+// ScopedTokenAuthentication authentication function, it receives the token
+// and returns the principal along with the scopes granted to the token
+type ScopedTokenAuthentication func(string) (interface{}, []string, error)
+
 // BasicAuth creates a basic auth authenticator with the provided authentication function
 func BasicAuth(authenticate UserPassAuthentication) httpkit.Authenticator {
 	return httpAuthenticator(func(r *http.Request) (bool, interface{}, error) {
@@ -63,3 +91,44 @@ func APIKeyAuth(name, in string, authenticate TokenAuthentication) httpkit.Authe
 		return true, p, err
 	})
 }
+
+// BearerAuth creates an authenticator for oauth2 bearer access tokens.
+// The token is read from the Authorization header or from the access_token query parameter.
+// The authentication function returns the scopes granted to the token, when those lack
+// any of the scopes required for the matched operation the request is rejected with a 403.
+func BearerAuth(authenticate ScopedTokenAuthentication) httpkit.Authenticator {
+	return scopedAuthenticator(func(r *ScopedAuthRequest) (bool, interface{}, error) {
+		var token string
+		hdr := r.Request.Header.Get("Authorization")
+		if len(hdr) > len(bearerPrefix) && strings.EqualFold(hdr[:len(bearerPrefix)], bearerPrefix) {
+			token = strings.TrimSpace(hdr[len(bearerPrefix):])
+		}
+		if token == "" {
+			token = r.Request.URL.Query().Get("access_token")
+		}
+		if token == "" {
+			return false, nil, nil
+		}
+
+		p, granted, err := authenticate(token)
+		if err != nil {
+			return true, nil, err
+		}
+		for _, scope := range r.RequiredScopes {
+			if !containsScope(granted, scope) {
+				return true, nil, errors.Forbidden("oauth2")
+			}
+		}
+		return true, p, nil
+	})
+}
+
+// containsScope checks if the scope is among the granted scopes, scopes are case sensitive
+func containsScope(granted []string, scope string) bool {
+	for _, s := range granted {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package security
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var bearerAuth = ScopedTokenAuthentication(func(token string) (interface{}, []string, error) {
+	if token != "token123" {
+		return nil, nil, errors.Unauthenticated("bearer")
+	}
+	return "admin", []string{"pets:read"}, nil
+})
+
+func TestValidBearerAuth(t *testing.T) {
+	ba := BearerAuth(bearerAuth)
+
+	req1, _ := http.NewRequest("GET", "/blah?access_token=token123", nil)
+
+	ok, usr, err := ba.Authenticate(&ScopedAuthRequest{Request: req1, RequiredScopes: []string{"pets:read"}})
+	assert.True(t, ok)
+	assert.Equal(t, "admin", usr)
+	assert.NoError(t, err)
+
+	req2, _ := http.NewRequest("GET", "/blah", nil)
+	req2.Header.Set("Authorization", "Bearer token123")
+
+	ok, usr, err = ba.Authenticate(req2)
+	assert.True(t, ok)
+	assert.Equal(t, "admin", usr)
+	assert.NoError(t, err)
+
+	// the scheme of the header is case insensitive
+	req3, _ := http.NewRequest("GET", "/blah", nil)
+	req3.Header.Set("Authorization", "bearer token123")
+
+	ok, usr, err = ba.Authenticate(&ScopedAuthRequest{Request: req3, RequiredScopes: []string{"pets:read"}})
+	assert.True(t, ok)
+	assert.Equal(t, "admin", usr)
+	assert.NoError(t, err)
+}
+
+func TestInvalidBearerAuth(t *testing.T) {
+	ba := BearerAuth(bearerAuth)
+
+	req1, _ := http.NewRequest("GET", "/blah?access_token=token124", nil)
+
+	ok, usr, err := ba.Authenticate(req1)
+	assert.True(t, ok)
+	assert.Nil(t, usr)
+	assert.Error(t, err)
+
+	req2, _ := http.NewRequest("GET", "/blah", nil)
+	req2.Header.Set("Authorization", "Bearer token123")
+
+	ok, usr, err = ba.Authenticate(&ScopedAuthRequest{Request: req2, RequiredScopes: []string{"pets:write"}})
+	assert.True(t, ok)
+	assert.Nil(t, usr)
+	if assert.Error(t, err) {
+		assert.EqualValues(t, http.StatusForbidden, err.(errors.Error).Code())
+	}
+}
+
+func TestMissingBearerAuth(t *testing.T) {
+	ba := BearerAuth(bearerAuth)
+
+	req1, _ := http.NewRequest("GET", "/blah", nil)
+	req1.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+
+	ok, usr, err := ba.Authenticate(&ScopedAuthRequest{Request: req1})
+	assert.False(t, ok)
+	assert.Nil(t, usr)
+	assert.NoError(t, err)
+}
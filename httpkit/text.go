@@ -0,0 +1,66 @@
+package httpkit
+
+import (
+	"encoding"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// TextConsumer creates a new text consumer,
+// it reads the body into a string, a byte slice or a text unmarshaler
+func TextConsumer() Consumer {
+	return ConsumerFunc(func(reader io.Reader, data interface{}) error {
+		b, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return err
+		}
+		if len(b) == 0 {
+			return io.EOF
+		}
+
+		switch v := data.(type) {
+		case *string:
+			*v = string(b)
+		case *[]byte:
+			*v = b
+		case *interface{}:
+			*v = string(b)
+		case encoding.TextUnmarshaler:
+			return v.UnmarshalText(b)
+		default:
+			return fmt.Errorf("%T is not supported by the text consumer", data)
+		}
+		return nil
+	})
+}
+
+// TextProducer creates a new text producer,
+// it writes strings, byte slices, errors, fmt.Stringer and encoding.TextMarshaler values
+func TextProducer() Producer {
+	return ProducerFunc(func(writer io.Writer, data interface{}) error {
+		var b []byte
+		switch v := data.(type) {
+		case string:
+			b = []byte(v)
+		case *string:
+			b = []byte(*v)
+		case []byte:
+			b = v
+		case encoding.TextMarshaler:
+			t, err := v.MarshalText()
+			if err != nil {
+				return err
+			}
+			b = t
+		case fmt.Stringer:
+			b = []byte(v.String())
+		case error:
+			b = []byte(v.Error())
+		default:
+			return fmt.Errorf("%T is not supported by the text producer", data)
+		}
+		_, err := writer.Write(b)
+		return err
+	})
+}
@@ -0,0 +1,57 @@
+package httpkit
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var consProdText = `The quick brown fox jumped over the lazy dog.`
+
+func TestTextConsumer(t *testing.T) {
+	cons := TextConsumer()
+
+	var str string
+	err := cons.Consume(bytes.NewBuffer([]byte(consProdText)), &str)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdText, str)
+
+	var ip net.IP
+	err = cons.Consume(bytes.NewBuffer([]byte("127.0.0.1")), &ip)
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1", ip.String())
+
+	var untyped interface{}
+	err = cons.Consume(bytes.NewBuffer([]byte(consProdText)), &untyped)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdText, untyped)
+
+	var num int
+	assert.Error(t, cons.Consume(bytes.NewBuffer([]byte("1")), &num))
+}
+
+func TestTextProducer(t *testing.T) {
+	prod := TextProducer()
+
+	rw := httptest.NewRecorder()
+	err := prod.Produce(rw, consProdText)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdText, rw.Body.String())
+
+	rw = httptest.NewRecorder()
+	err = prod.Produce(rw, net.ParseIP("127.0.0.1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1", rw.Body.String())
+
+	rw = httptest.NewRecorder()
+	err = prod.Produce(rw, errors.New(consProdText))
+	assert.NoError(t, err)
+	assert.Equal(t, consProdText, rw.Body.String())
+
+	rw = httptest.NewRecorder()
+	assert.Error(t, prod.Produce(rw, 1))
+}
@@ -0,0 +1,38 @@
+package httpkit
+
+import (
+	"bytes"
+	"encoding/xml"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var consProdXML = `<person><name>Somebody</name><id>1</id></person>`
+
+func TestXMLConsumer(t *testing.T) {
+	cons := XMLConsumer()
+	var data struct {
+		Name string `xml:"name"`
+		ID   int    `xml:"id"`
+	}
+	err := cons.Consume(bytes.NewBuffer([]byte(consProdXML)), &data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Somebody", data.Name)
+	assert.Equal(t, 1, data.ID)
+}
+
+func TestXMLProducer(t *testing.T) {
+	prod := XMLProducer()
+	data := struct {
+		XMLName xml.Name `xml:"person"`
+		Name    string   `xml:"name"`
+		ID      int      `xml:"id"`
+	}{Name: "Somebody", ID: 1}
+
+	rw := httptest.NewRecorder()
+	err := prod.Produce(rw, data)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdXML, rw.Body.String())
+}
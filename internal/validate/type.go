@@ -126,8 +126,10 @@ func (t *typeValidator) Validate(data interface{}) *Result {
 	schType, format := t.schemaInfoForType(data)
 	isLowerInt := t.Format == "int64" && format == "int32"
 	isLowerFloat := t.Format == "float64" && format == "float32"
+	// numbers decoded from json are float64, those without a fraction satisfy an integer format
+	isJSONInt := format == "float64" && t.Type.Contains("integer") && swag.IsFloat64AJSONInteger(val.Float())
 
-	if val.Kind() != reflect.String && t.Format != "" && !(format == t.Format || isLowerInt || isLowerFloat) {
+	if val.Kind() != reflect.String && t.Format != "" && !(format == t.Format || isLowerInt || isLowerFloat || isJSONInt) {
 		return sErr(errors.InvalidType(t.Path, t.In, t.Format, format))
 	}
 	if t.Format != "" && val.Kind() == reflect.String {
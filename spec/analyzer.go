@@ -1,6 +1,7 @@
 package spec
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/go-swagger/go-swagger/swag"
@@ -74,8 +75,11 @@ type SecurityRequirement struct {
 	Scopes []string
 }
 
-// SecurityRequirementsFor gets the security requirements for the operation
-func (s *specAnalyzer) SecurityRequirementsFor(operation *Operation) []SecurityRequirement {
+// SecurityRequirementsFor gets the security requirements for the operation.
+// The result is a list of alternatives of which one needs to be satisfied,
+// every requirement in an alternative needs to be satisfied together.
+// An empty alternative means the operation can also be accessed anonymously.
+func (s *specAnalyzer) SecurityRequirementsFor(operation *Operation) [][]SecurityRequirement {
 	if s.spec.Security == nil && operation.Security == nil {
 		return nil
 	}
@@ -85,18 +89,14 @@ func (s *specAnalyzer) SecurityRequirementsFor(operation *Operation) []SecurityR
 		schemes = operation.Security
 	}
 
-	unique := make(map[string]SecurityRequirement)
+	var result [][]SecurityRequirement
 	for _, scheme := range schemes {
+		var reqs []SecurityRequirement
 		for k, v := range scheme {
-			if _, ok := unique[k]; !ok {
-				unique[k] = SecurityRequirement{Name: k, Scopes: v}
-			}
+			reqs = append(reqs, SecurityRequirement{Name: k, Scopes: v})
 		}
-	}
-
-	var result []SecurityRequirement
-	for _, v := range unique {
-		result = append(result, v)
+		sort.Sort(securityRequirements(reqs))
+		result = append(result, reqs)
 	}
 	return result
 }
@@ -108,16 +108,24 @@ func (s *specAnalyzer) SecurityDefinitionsFor(operation *Operation) map[string]S
 		return nil
 	}
 	result := make(map[string]SecurityScheme)
-	for _, v := range requirements {
-		if definition, ok := s.spec.SecurityDefinitions[v.Name]; ok {
-			if definition != nil {
-				result[v.Name] = *definition
+	for _, reqs := range requirements {
+		for _, v := range reqs {
+			if definition, ok := s.spec.SecurityDefinitions[v.Name]; ok {
+				if definition != nil {
+					result[v.Name] = *definition
+				}
 			}
 		}
 	}
 	return result
 }
 
+type securityRequirements []SecurityRequirement
+
+func (s securityRequirements) Len() int           { return len(s) }
+func (s securityRequirements) Less(i, j int) bool { return s[i].Name < s[j].Name }
+func (s securityRequirements) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
 // ConsumesFor gets the mediatypes for the operation
 func (s *specAnalyzer) ConsumesFor(operation *Operation) []string {
 	cons := make(map[string]struct{})
@@ -7,10 +7,10 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func schemeNames(schemes []SecurityRequirement) []string {
+func schemeNames(schemes [][]SecurityRequirement) []string {
 	var names []string
 	for _, v := range schemes {
-		names = append(names, v.Name)
+		names = append(names, v[0].Name)
 	}
 	sort.Sort(sort.StringSlice(names))
 	return names
@@ -87,7 +87,10 @@ func TestAnalyzer(t *testing.T) {
 	sort.Sort(sort.StringSlice(produces))
 	assert.Equal(t, expected, produces)
 
-	expectedSchemes := []SecurityRequirement{SecurityRequirement{"oauth2", []string{}}, SecurityRequirement{"basic", nil}}
+	expectedSchemes := [][]SecurityRequirement{
+		[]SecurityRequirement{SecurityRequirement{"oauth2", []string{}}},
+		[]SecurityRequirement{SecurityRequirement{"basic", nil}},
+	}
 	schemes := analyzer.SecurityRequirementsFor(spec.Paths.Paths["/"].Get)
 	assert.Equal(t, schemeNames(expectedSchemes), schemeNames(schemes))
 
@@ -120,3 +123,34 @@ func TestAnalyzer(t *testing.T) {
 	assert.False(t, ok)
 	assert.Nil(t, op)
 }
+
+func TestSecurityRequirementsFor(t *testing.T) {
+	op := &Operation{}
+	op.Security = []map[string][]string{
+		map[string][]string{"basic": nil, "apiKey": nil},
+		map[string][]string{"oauth2": []string{"read"}},
+	}
+
+	spec := &Swagger{
+		swaggerProps: swaggerProps{
+			SecurityDefinitions: map[string]*SecurityScheme{
+				"basic":  BasicAuth(),
+				"apiKey": APIKeyAuth("api_key", "query"),
+				"oauth2": OAuth2AccessToken("http://authorize.com", "http://token.com"),
+			},
+			Paths: &Paths{
+				Paths: map[string]PathItem{
+					"/": PathItem{pathItemProps: pathItemProps{Get: op}},
+				},
+			},
+		},
+	}
+	analyzer := newAnalyzer(spec)
+
+	schemes := analyzer.SecurityRequirementsFor(op)
+	if assert.Len(t, schemes, 2) {
+		assert.Equal(t, []SecurityRequirement{{"apiKey", nil}, {"basic", nil}}, schemes[0])
+		assert.Equal(t, []SecurityRequirement{{"oauth2", []string{"read"}}}, schemes[1])
+	}
+	assert.Len(t, analyzer.SecurityDefinitionsFor(op), 3)
+}
@@ -51,7 +51,7 @@ func MustLoadJSONSchemaDraft04() *Schema {
 
 // JSONSchemaDraft04 loads the json schema document for json shema draft04
 func JSONSchemaDraft04() (*Schema, error) {
-	b, err := assets.Asset("jsonschema-draft-04.json")
+	b, err := assets.Asset("schemas/jsonschema-draft-04.json")
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +75,7 @@ func MustLoadSwagger20Schema() *Schema {
 // Swagger20Schema loads the swagger 2.0 schema from the embedded asses
 func Swagger20Schema() (*Schema, error) {
 
-	b, err := assets.Asset("2.0/schema.json")
+	b, err := assets.Asset("schemas/2.0/schema.json")
 	if err != nil {
 		return nil, err
 	}
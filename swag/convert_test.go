@@ -0,0 +1,53 @@
+package swag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatConvertRoundTrip(t *testing.T) {
+	b, err := ConvertBool(FormatBool(true))
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	f32, err := ConvertFloat32(FormatFloat32(1.5))
+	assert.NoError(t, err)
+	assert.Equal(t, float32(1.5), f32)
+
+	f64, err := ConvertFloat64(FormatFloat64(-2.25))
+	assert.NoError(t, err)
+	assert.Equal(t, -2.25, f64)
+
+	i8, err := ConvertInt8(FormatInt8(-8))
+	assert.NoError(t, err)
+	assert.Equal(t, int8(-8), i8)
+
+	i16, err := ConvertInt16(FormatInt16(-16))
+	assert.NoError(t, err)
+	assert.Equal(t, int16(-16), i16)
+
+	i32, err := ConvertInt32(FormatInt32(-32))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(-32), i32)
+
+	i64, err := ConvertInt64(FormatInt64(-64))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-64), i64)
+
+	u8, err := ConvertUint8(FormatUint8(8))
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(8), u8)
+
+	u16, err := ConvertUint16(FormatUint16(16))
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(16), u16)
+
+	u32, err := ConvertUint32(FormatUint32(32))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(32), u32)
+
+	u64, err := ConvertUint64(FormatUint64(64))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(64), u64)
+}
@@ -72,6 +72,32 @@ func SplitByFormat(data, format string) []string {
 	return result
 }
 
+// JoinByFormat joins a string array by a known format:
+// ssv: space separated value
+// tsv: tab separated value
+// pipes: pipe (|) separated value
+// csv: comma separated value (default)
+// multi: the values are returned as is, they are meant to be repeated
+func JoinByFormat(data []string, format string) []string {
+	if len(data) == 0 {
+		return data
+	}
+	var sep string
+	switch format {
+	case "ssv":
+		sep = " "
+	case "tsv":
+		sep = "\t"
+	case "pipes":
+		sep = "|"
+	case "multi":
+		return data
+	default:
+		sep = ","
+	}
+	return []string{strings.Join(data, sep)}
+}
+
 // Prepares strings by splitting by caps, spaces, dashes, and underscore
 func split(str string) (words []string) {
 	repl := strings.NewReplacer("-", " ", "_", " ")
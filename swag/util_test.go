@@ -52,3 +52,15 @@ func TestContainsStringsCI(t *testing.T) {
 	assert.True(t, ContainsStringsCI(list, "AND"))
 	assert.False(t, ContainsStringsCI(list, "nuts"))
 }
+
+func TestJoinByFormat(t *testing.T) {
+	values := []string{"one", "two", "three"}
+
+	assert.Equal(t, []string{"one,two,three"}, JoinByFormat(values, ""))
+	assert.Equal(t, []string{"one,two,three"}, JoinByFormat(values, "csv"))
+	assert.Equal(t, []string{"one two three"}, JoinByFormat(values, "ssv"))
+	assert.Equal(t, []string{"one\ttwo\tthree"}, JoinByFormat(values, "tsv"))
+	assert.Equal(t, []string{"one|two|three"}, JoinByFormat(values, "pipes"))
+	assert.Equal(t, values, JoinByFormat(values, "multi"))
+	assert.Empty(t, JoinByFormat(nil, "csv"))
+}
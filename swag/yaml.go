@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"gopkg.in/yaml.v2"
 )
 
 // YAMLToJSON converts YAML unmarshaled data into json compatible data
@@ -16,6 +18,16 @@ func YAMLToJSON(data interface{}) (json.RawMessage, error) {
 	return json.RawMessage(b), err
 }
 
+// JSONToYAML converts a json document into a yaml document, the order of the keys is preserved
+func JSONToYAML(data []byte) ([]byte, error) {
+	// a json document is also a valid yaml document
+	var doc yaml.MapSlice
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(doc)
+}
+
 func transformData(in interface{}) (out interface{}, err error) {
 	switch in.(type) {
 	case map[interface{}]interface{}:
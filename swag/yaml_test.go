@@ -101,3 +101,12 @@ func TestYAMLToJSON(t *testing.T) {
 	assert.Equal(t, json.RawMessage(`{"description":"object created"}`), d)
 
 }
+
+func TestJSONToYAML(t *testing.T) {
+	d, err := JSONToYAML([]byte(`{"swagger":"2.0","info":{"title":"tasks","version":"1.0.0"},"schemes":["http","https"]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "swagger: \"2.0\"\ninfo:\n  title: tasks\n  version: 1.0.0\nschemes:\n- http\n- https\n", string(d))
+
+	_, err = JSONToYAML([]byte(`["not", "an", "object"]`))
+	assert.Error(t, err)
+}